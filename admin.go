@@ -0,0 +1,228 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"gw2lfgserver/clientinfo"
+	"gw2lfgserver/database"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminServer implements moderation RPCs. Only accounts configured as
+// admins get past the authenticator.
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	db  *database.DB
+	lfg *Server
+}
+
+func NewAdminServer(db *database.DB, lfg *Server) *AdminServer {
+	return &AdminServer{
+		db:  db,
+		lfg: lfg,
+	}
+}
+
+func (s *AdminServer) ListGroups(ctx context.Context, req *pb.AdminListGroupsRequest) (*pb.AdminListGroupsResponse, error) {
+	groups, err := s.db.ListGroups(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListGroups", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list groups")
+	}
+
+	now := time.Now()
+	adminGroups := make([]*pb.AdminGroup, 0, len(groups))
+	for _, group := range groups {
+		applications, err := s.db.ListApplicationsForGroup(ctx, group.Id)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.ListApplicationsForGroup", "err", err)
+			return nil, status.Error(codes.Internal, "Failed to list applications")
+		}
+		ban, err := s.db.GetActiveBan(ctx, group.CreatorId, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.GetActiveBan", "err", err)
+			return nil, status.Error(codes.Internal, "Failed to get creator ban")
+		}
+		adminGroups = append(adminGroups, &pb.AdminGroup{
+			Group:        group,
+			Applications: applications,
+			CreatorBan:   ban,
+		})
+	}
+
+	return &pb.AdminListGroupsResponse{Groups: adminGroups}, nil
+}
+
+func (s *AdminServer) ForceDeleteGroup(ctx context.Context, req *pb.ForceDeleteGroupRequest) (*pb.ForceDeleteGroupResponse, error) {
+	group, err := s.db.GetGroup(ctx, req.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get group")
+	}
+	if group == nil {
+		return nil, status.Error(codes.NotFound, "Group not found")
+	}
+
+	if err := s.lfg.deleteGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, "delete_group", group.Id, req.Reason)
+
+	return &pb.ForceDeleteGroupResponse{}, nil
+}
+
+func (s *AdminServer) ForceDeleteApplication(ctx context.Context, req *pb.ForceDeleteApplicationRequest) (*pb.ForceDeleteApplicationResponse, error) {
+	application, err := s.db.GetApplication(ctx, req.ApplicationId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get application")
+	}
+	if application == nil {
+		return nil, status.Error(codes.NotFound, "Application not found")
+	}
+
+	if err := s.db.DeleteApplication(ctx, application.Id); err != nil {
+		slog.ErrorContext(ctx, "s.db.DeleteApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to delete application")
+	}
+	s.lfg.releaseSlots(ctx, application)
+	s.lfg.broadcastApplicationUpdate(application.GroupId, application.AccountName, &pb.GroupApplicationUpdate{
+		Update: &pb.GroupApplicationUpdate_RemovedApplicationId{
+			RemovedApplicationId: application.Id,
+		},
+	})
+	s.audit(ctx, "delete_application", application.Id, req.Reason)
+
+	return &pb.ForceDeleteApplicationResponse{}, nil
+}
+
+func (s *AdminServer) BanAccount(ctx context.Context, req *pb.BanAccountRequest) (*pb.BanAccountResponse, error) {
+	if req.AccountName == "" {
+		return nil, status.Error(codes.InvalidArgument, "Account name is required")
+	}
+	if req.DurationSec < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Duration must not be negative")
+	}
+
+	now := time.Now()
+	ban := &pb.Ban{
+		AccountName:  req.AccountName,
+		Reason:       req.Reason,
+		BannedBy:     clientinfo.FromContext(ctx).AccountName,
+		CreatedAtSec: now.Unix(),
+	}
+	if req.DurationSec > 0 {
+		ban.ExpiresAtSec = now.Add(time.Duration(req.DurationSec) * time.Second).Unix()
+	}
+
+	ban, err := s.db.SaveBan(ctx, ban)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveBan", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to ban account")
+	}
+	s.audit(ctx, "ban", req.AccountName, req.Reason)
+
+	return &pb.BanAccountResponse{Ban: ban}, nil
+}
+
+func (s *AdminServer) UnbanAccount(ctx context.Context, req *pb.UnbanAccountRequest) (*pb.UnbanAccountResponse, error) {
+	found, err := s.db.DeleteBan(ctx, req.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.DeleteBan", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to unban account")
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "Account is not banned")
+	}
+	s.audit(ctx, "unban", req.AccountName, req.Reason)
+
+	return &pb.UnbanAccountResponse{}, nil
+}
+
+func (s *AdminServer) ListBans(ctx context.Context, req *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	bans, err := s.db.ListActiveBans(ctx, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListActiveBans", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list bans")
+	}
+	return &pb.ListBansResponse{Bans: bans}, nil
+}
+
+func (s *AdminServer) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	subscriptions := s.lfg.subscriptions.Snapshot()
+	slices.SortFunc(subscriptions, func(a, b *pb.Subscription) int {
+		return cmp.Compare(a.StartedAtSec, b.StartedAtSec)
+	})
+	return &pb.ListSubscriptionsResponse{Subscriptions: subscriptions}, nil
+}
+
+func (s *AdminServer) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	reports, err := s.db.ListReports(ctx, req.IncludeResolved)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListReports", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list reports")
+	}
+	return &pb.ListReportsResponse{Reports: reports}, nil
+}
+
+func (s *AdminServer) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ResolveReportResponse, error) {
+	report, err := s.db.GetReport(ctx, req.ReportId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetReport", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get report")
+	}
+	if report == nil {
+		return nil, status.Error(codes.NotFound, "Report not found")
+	}
+	if req.UnhideGroup && report.TargetType != pb.ReportTargetType_REPORT_TARGET_GROUP {
+		return nil, status.Error(codes.InvalidArgument, "Report is not about a group")
+	}
+
+	reports, err := s.db.ResolveReports(ctx, report.TargetType, report.TargetId,
+		clientinfo.FromContext(ctx).AccountName, req.Resolution, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ResolveReports", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to resolve report")
+	}
+
+	if req.UnhideGroup {
+		group, err := s.db.GetGroup(ctx, report.TargetId)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+			return nil, status.Error(codes.Internal, "Failed to get group")
+		}
+		if group != nil && group.Hidden {
+			unhidden, err := s.db.SetGroupHidden(ctx, group.Id, false)
+			if err != nil {
+				slog.ErrorContext(ctx, "s.db.SetGroupHidden", "err", err)
+				return nil, status.Error(codes.Internal, "Failed to unhide group")
+			}
+			if unhidden != nil {
+				s.lfg.broadcastGroupChange(group, unhidden)
+			}
+		}
+	}
+	s.audit(ctx, "resolve_report", report.TargetId, req.Resolution)
+
+	return &pb.ResolveReportResponse{Reports: reports}, nil
+}
+
+// audit records an admin action.
+func (s *AdminServer) audit(ctx context.Context, action, target, reason string) {
+	err := s.db.WriteAuditLog(ctx, database.AuditEntry{
+		Admin:     clientinfo.FromContext(ctx).AccountName,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.WriteAuditLog", "err", err, "action", action, "target", target)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"gw2lfgserver/authenticator"
+	"gw2lfgserver/clientinfo"
+	"gw2lfgserver/keyresolver"
+	pb "gw2lfgserver/pb"
+	"gw2lfgserver/session"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuthServer struct {
+	pb.UnimplementedAuthServiceServer
+	keyResolver *keyresolver.Resolver
+	signer      *session.Signer
+	bans        authenticator.BanStore
+	sessionTTL  time.Duration
+	refreshTTL  time.Duration
+}
+
+func NewAuthServer(keyResolver *keyresolver.Resolver, signer *session.Signer, bans authenticator.BanStore, sessionTTL, refreshTTL time.Duration) *AuthServer {
+	return &AuthServer{
+		keyResolver: keyResolver,
+		signer:      signer,
+		bans:        bans,
+		sessionTTL:  sessionTTL,
+		refreshTTL:  refreshTTL,
+	}
+}
+
+func (s *AuthServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	if req.ApiKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "API key is required")
+	}
+
+	keyInfo, err := s.keyResolver.Resolve(ctx, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := s.issue(keyInfo.AccountName, keyInfo.Permissions, keyInfo.Profile, keyInfo.ExpiresAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.issue", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create session")
+	}
+	return &pb.LoginResponse{Session: sess}, nil
+}
+
+func (s *AuthServer) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.RefreshSessionResponse, error) {
+	claims, err := s.signer.Verify(req.RefreshToken, session.TypeRefresh)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
+	}
+	// Bans are checked on every call as well, this only stops renewing
+	ban, err := s.bans.GetActiveBan(ctx, claims.AccountName, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "s.bans.GetActiveBan", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to refresh session")
+	}
+	if ban != nil {
+		return nil, status.Error(codes.PermissionDenied, "Account is banned")
+	}
+
+	// The refresh token already carries the lifetime limit of the original
+	// login, so a refresh can never extend past it.
+	sess, err := s.issue(claims.AccountName, claims.Permissions, claims.Profile, time.Unix(claims.ExpiresAt, 0))
+	if err != nil {
+		slog.ErrorContext(ctx, "s.issue", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to refresh session")
+	}
+	return &pb.RefreshSessionResponse{Session: sess}, nil
+}
+
+// issue creates a session and refresh token pair, neither of which outlives
+// notAfter unless it is zero.
+func (s *AuthServer) issue(accountName string, permissions []string, profile *clientinfo.Profile, notAfter time.Time) (*pb.Session, error) {
+	now := time.Now()
+	sessionExpiry := now.Add(s.sessionTTL)
+	refreshExpiry := now.Add(s.refreshTTL)
+	if !notAfter.IsZero() {
+		if sessionExpiry.After(notAfter) {
+			sessionExpiry = notAfter
+		}
+		if refreshExpiry.After(notAfter) {
+			refreshExpiry = notAfter
+		}
+	}
+
+	sessionToken, err := s.signer.Sign(session.Claims{
+		Type:        session.TypeSession,
+		AccountName: accountName,
+		Permissions: permissions,
+		Profile:     profile,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   sessionExpiry.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := s.signer.Sign(session.Claims{
+		Type:        session.TypeRefresh,
+		AccountName: accountName,
+		Permissions: permissions,
+		Profile:     profile,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   refreshExpiry.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Session{
+		AccountName:         accountName,
+		SessionToken:        sessionToken,
+		SessionExpiresAtSec: sessionExpiry.Unix(),
+		RefreshToken:        refreshToken,
+		RefreshExpiresAtSec: refreshExpiry.Unix(),
+	}, nil
+}
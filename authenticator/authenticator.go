@@ -4,37 +4,183 @@ import (
 	"context"
 	"gw2lfgserver/clientinfo"
 	"gw2lfgserver/keyresolver"
+	pb "gw2lfgserver/pb"
+	"gw2lfgserver/session"
+	"gw2lfgserver/tokenhash"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// Policy decides how calls to a method are authenticated.
+type Policy int
+
+const (
+	// Required rejects calls without valid credentials. It is the default
+	// for methods without a policy.
+	Required Policy = iota
+	// Public methods never look at credentials.
+	Public
+	// Optional methods authenticate the caller if credentials are present,
+	// and let anonymous calls through otherwise.
+	Optional
+	// Admin methods require the caller to be a configured admin.
+	Admin
+)
+
+// Policies maps full method names ("/package.Service/Method") or whole
+// services ("/package.Service/") to their policy.
+type Policies map[string]Policy
+
+// BanStore looks up account bans.
+type BanStore interface {
+	GetActiveBan(ctx context.Context, accountName string, t time.Time) (*pb.Ban, error)
+}
+
+// Config holds configuration for the authenticator
+type Config struct {
+	Policies Policies
+	// Admins are the account names allowed to call Admin methods.
+	Admins []string
+	// Bans rejects banned accounts if set.
+	Bans BanStore
+	// ReadMethods lists methods or services that banned accounts may still
+	// call, in the same format as Policies.
+	ReadMethods map[string]bool
+}
+
 type Authenticator struct {
 	keyResolver *keyresolver.Resolver
+	signer      *session.Signer
+	hasher      *tokenhash.Hasher
+	policies    Policies
+	admins      []string
+	bans        BanStore
+	readMethods map[string]bool
 }
 
-func New(r *keyresolver.Resolver) *Authenticator {
-	return &Authenticator{keyResolver: r}
+func New(cfg Config, r *keyresolver.Resolver, signer *session.Signer, hasher *tokenhash.Hasher) *Authenticator {
+	return &Authenticator{
+		keyResolver: r,
+		signer:      signer,
+		hasher:      hasher,
+		policies:    cfg.Policies,
+		admins:      cfg.Admins,
+		bans:        cfg.Bans,
+		readMethods: cfg.ReadMethods,
+	}
 }
 
+// Authenticate applies the policy of the called method.
 func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	method, _ := grpc.Method(ctx)
+	switch lookup(a.policies, method, Required) {
+	case Public:
+		return ctx, nil
+	case Optional:
+		if _, err := grpc_auth.AuthFromMD(ctx, "bearer"); err != nil {
+			return ctx, nil
+		}
+	case Admin:
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !clientinfo.FromContext(ctx).Admin {
+			return nil, status.Error(codes.PermissionDenied, "admin access required")
+		}
+		return ctx, nil
+	}
+
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Banned accounts can still look around, they just can't change anything
+	if !lookup(a.readMethods, method, false) {
+		if err := a.checkBan(ctx, clientinfo.FromContext(ctx).AccountName); err != nil {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
+func (a *Authenticator) checkBan(ctx context.Context, accountName string) error {
+	if a.bans == nil {
+		return nil
+	}
+	ban, err := a.bans.GetActiveBan(ctx, accountName, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "a.bans.GetActiveBan", "err", err)
+		return status.Error(codes.Internal, "failed to check account status")
+	}
+	if ban == nil {
+		return nil
+	}
+	if ban.ExpiresAtSec == 0 {
+		return status.Errorf(codes.PermissionDenied, "account is banned: %s", ban.Reason)
+	}
+	return status.Errorf(codes.PermissionDenied, "account is banned until %s: %s",
+		time.Unix(ban.ExpiresAtSec, 0).UTC().Format(time.RFC3339), ban.Reason)
+}
+
+// lookup finds the entry for a method, falling back to the entry of its
+// service and then to def.
+func lookup[T any](entries map[string]T, method string, def T) T {
+	if entry, ok := entries[method]; ok {
+		return entry
+	}
+	if i := strings.LastIndexByte(method, '/'); i >= 0 {
+		if entry, ok := entries[method[:i+1]]; ok {
+			return entry
+		}
+	}
+	return def
+}
+
+// authenticate accepts either a session token issued by Login, which is
+// verified locally, or a raw GW2 API key.
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
 	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
 	if err != nil {
 		return nil, err
 	}
 
-	clientId, err := a.keyResolver.Resolve(ctx, token)
+	if strings.HasPrefix(token, session.Prefix) {
+		claims, err := a.signer.Verify(token, session.TypeSession)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid session token: %v", err)
+		}
+
+		return clientinfo.ToContext(ctx, &clientinfo.ClientInfo{
+			AccountName: claims.AccountName,
+			TokenHash:   a.hasher.Hash(token),
+			Permissions: claims.Permissions,
+			Profile:     claims.Profile,
+			Admin:       slices.Contains(a.admins, claims.AccountName),
+		}), nil
+	}
+
+	keyInfo, err := a.keyResolver.Resolve(ctx, token)
 	if err != nil {
 		return nil, err
 	}
 
-	if clientId == "" {
+	if keyInfo.AccountName == "" {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid auth token")
 	}
 
 	return clientinfo.ToContext(ctx, &clientinfo.ClientInfo{
-		AccountName: clientId,
-		Token:       token,
+		AccountName: keyInfo.AccountName,
+		TokenHash:   a.hasher.Hash(token),
+		Permissions: keyInfo.Permissions,
+		Profile:     keyInfo.Profile,
+		Admin:       slices.Contains(a.admins, keyInfo.AccountName),
 	}), nil
 }
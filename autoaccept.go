@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"gw2lfgserver/kpme"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateAutoAccept checks the auto-accept settings of a group against
+// its requirements and slots.
+func validateAutoAccept(group *pb.Group) error {
+	settings := group.GetAutoAccept()
+	if _, ok := pb.AutoAcceptMode_name[int32(settings.GetMode())]; !ok {
+		return status.Error(codes.InvalidArgument, "Unknown auto-accept mode")
+	}
+	if settings.GetCapacity() > maxSlotPlaces {
+		return status.Errorf(codes.InvalidArgument, "Auto-accept capacity must be at most %d", maxSlotPlaces)
+	}
+	switch settings.GetMode() {
+	case pb.AutoAcceptMode_AUTO_ACCEPT_KILL_PROOF:
+		if group.KillProofId == pb.KillProofId_KP_UNKNOWN {
+			return status.Error(codes.InvalidArgument, "Auto-accepting by kill proof requires a kill proof requirement")
+		}
+	case pb.AutoAcceptMode_AUTO_ACCEPT_FIRST_COME:
+		if settings.GetCapacity() == 0 && len(group.Slots) == 0 {
+			return status.Error(codes.InvalidArgument, "Auto-accepting first come requires a capacity or slots")
+		}
+	}
+	return nil
+}
+
+// killProofCount returns the amount of the kill proof id in kp.
+func killProofCount(kp *pb.KillProof, id pb.KillProofId) int32 {
+	switch id {
+	case pb.KillProofId_KP_LI:
+		return kp.Li
+	case pb.KillProofId_KP_BSKP:
+		return kp.Bskp
+	case pb.KillProofId_KP_UFE:
+		return kp.Ufe
+	}
+	return 0
+}
+
+// saveNewApplication stores a new application, accepted right away if the
+// auto-accept settings of its group allow it. Deciding and saving happen
+// under the group's slot lock, so concurrent applications can't both take
+// the last place.
+func (s *Server) saveNewApplication(ctx context.Context, application *pb.GroupApplication) (*pb.GroupApplication, error) {
+	unlock := s.slotLocks.lock(application.GroupId)
+	defer unlock()
+
+	group, err := s.db.GetGroup(ctx, application.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create application")
+	}
+	if group == nil {
+		return nil, status.Error(codes.NotFound, "Group not found")
+	}
+	s.autoAccept(ctx, group, application)
+
+	savedApp, err := s.db.SaveApplication(ctx, application, group.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create application")
+	}
+	if savedApp.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED {
+		s.refreshSlots(ctx, group.Id)
+	}
+	savedApp.KillProof = application.KillProof
+	return savedApp, nil
+}
+
+// autoAccept accepts a new application if the auto-accept settings of group
+// allow it, into the first open slot matching the applicant's role. The
+// application stays pending otherwise, lookups failing included.
+func (s *Server) autoAccept(ctx context.Context, group *pb.Group, application *pb.GroupApplication) {
+	settings := group.GetAutoAccept()
+	switch settings.GetMode() {
+	case pb.AutoAcceptMode_AUTO_ACCEPT_KILL_PROOF:
+		if application.KillProof == nil {
+			kp, err := s.kpClient.GetKillProof(application.AccountName)
+			if err != nil {
+				slog.ErrorContext(ctx, "s.kpClient.GetKillProof", "err", err)
+				return
+			}
+			if kp == nil {
+				return
+			}
+			application.KillProof = kpme.KillProofReponseToProto(kp)
+		}
+		if killProofCount(application.KillProof, group.KillProofId) < int32(group.KillProofMinimum) {
+			return
+		}
+	case pb.AutoAcceptMode_AUTO_ACCEPT_FIRST_COME:
+	case pb.AutoAcceptMode_AUTO_ACCEPT_WHITELIST:
+		whitelisted, err := s.db.IsWhitelisted(ctx, group.CreatorId, application.AccountName)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.IsWhitelisted", "err", err)
+			return
+		}
+		if !whitelisted {
+			return
+		}
+	default:
+		return
+	}
+
+	if settings.Capacity > 0 {
+		applications, err := s.db.ListApplicationsForGroup(ctx, group.Id)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.ListApplicationsForGroup", "err", err)
+			return
+		}
+		accepted := 0
+		for _, other := range applications {
+			if other.Id != application.Id && other.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED {
+				accepted++
+			}
+		}
+		if accepted >= int(settings.Capacity) {
+			return
+		}
+	}
+	slot, err := acceptSlot(group, application, "")
+	if err != nil {
+		return
+	}
+	if slot != nil {
+		application.SlotId = slot.Id
+	}
+	application.Status = pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED
+}
+
+func (s *Server) WhitelistAccount(ctx context.Context, req *pb.WhitelistAccountRequest) (*pb.WhitelistAccountResponse, error) {
+	client := mustGetClient(ctx)
+	if req.AccountName == "" {
+		return nil, status.Error(codes.InvalidArgument, "Account name is required")
+	}
+	if req.AccountName == client.AccountName {
+		return nil, status.Error(codes.InvalidArgument, "Cannot whitelist yourself")
+	}
+
+	if err := s.db.WhitelistAccount(ctx, client.AccountName, req.AccountName, time.Now()); err != nil {
+		slog.ErrorContext(ctx, "s.db.WhitelistAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to whitelist account")
+	}
+	return &pb.WhitelistAccountResponse{}, nil
+}
+
+func (s *Server) UnwhitelistAccount(ctx context.Context, req *pb.UnwhitelistAccountRequest) (*pb.UnwhitelistAccountResponse, error) {
+	client := mustGetClient(ctx)
+
+	found, err := s.db.UnwhitelistAccount(ctx, client.AccountName, req.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.UnwhitelistAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to remove account from whitelist")
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "Account is not whitelisted")
+	}
+	return &pb.UnwhitelistAccountResponse{}, nil
+}
+
+func (s *Server) ListWhitelisted(ctx context.Context, req *pb.ListWhitelistedRequest) (*pb.ListWhitelistedResponse, error) {
+	client := mustGetClient(ctx)
+
+	whitelisted, err := s.db.ListWhitelisted(ctx, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListWhitelisted", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list whitelisted accounts")
+	}
+	return &pb.ListWhitelistedResponse{Whitelisted: whitelisted}, nil
+}
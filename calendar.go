@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"gw2lfgserver/database"
+	"gw2lfgserver/ical"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	calendarPathPrefix = "/calendar/"
+	publicCalendarName = "public"
+	// defaultEventDuration is the length of calendar events when groups
+	// are never closed.
+	defaultEventDuration = 2 * time.Hour
+)
+
+func (s *Server) GetCalendarFeed(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+	client := mustGetClient(ctx)
+	token, err := s.db.GetCalendarToken(ctx, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetCalendarToken", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get calendar feed")
+	}
+	if token == "" || req.Reset_ {
+		token = newCalendarToken()
+		if err := s.db.SaveCalendarToken(ctx, client.AccountName, token, time.Now()); err != nil {
+			slog.ErrorContext(ctx, "s.db.SaveCalendarToken", "err", err)
+			return nil, status.Error(codes.Internal, "Failed to create calendar feed")
+		}
+	}
+	return &pb.GetCalendarFeedResponse{Path: calendarPathPrefix + token + ".ics"}, nil
+}
+
+func newCalendarToken() string {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))
+}
+
+// CalendarHandler serves upcoming scheduled groups as iCalendar feeds. The
+// public feed lists all public groups, an account's feed at the path
+// returned by GetCalendarFeed lists the groups it created or applied to.
+func (s *Server) CalendarHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, calendarPathPrefix), ".ics")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		now := time.Now()
+		var calendar *ical.Calendar
+		var err error
+		if name == publicCalendarName {
+			calendar, err = s.publicCalendar(ctx, now)
+		} else {
+			var accountName string
+			accountName, err = s.db.GetCalendarAccount(ctx, name)
+			if err == nil && accountName == "" {
+				http.NotFound(w, r)
+				return
+			}
+			if err == nil {
+				calendar, err = s.accountCalendar(ctx, accountName, now)
+			}
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to build calendar", "err", err)
+			http.Error(w, "failed to build calendar", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		// The public feed is the same for everyone, shared caches may keep it
+		if name == publicCalendarName {
+			w.Header().Set("Cache-Control", "public, max-age=300")
+		} else {
+			w.Header().Set("Cache-Control", "private, max-age=300")
+		}
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := calendar.WriteTo(w); err != nil {
+			slog.DebugContext(ctx, "calendar.WriteTo", "err", err)
+		}
+	})
+}
+
+func (s *Server) publicCalendar(ctx context.Context, now time.Time) (*ical.Calendar, error) {
+	return s.calendar(ctx, "Guild Wars 2 LFG", now, func(group *pb.Group, _ []string) bool {
+		return canView(group, nil, "")
+	})
+}
+
+func (s *Server) accountCalendar(ctx context.Context, accountName string, now time.Time) (*ical.Calendar, error) {
+	applications, err := s.db.ListApplicationsForAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]bool, len(applications))
+	for _, application := range applications {
+		// Withdrawn applications are deleted, declined ones stay around
+		if application.Status != pb.ApplicationStatus_APPLICATION_STATUS_DECLINED {
+			applied[application.GroupId] = true
+		}
+	}
+	return s.calendar(ctx, "Guild Wars 2 LFG: "+accountName, now, func(group *pb.Group, accounts []string) bool {
+		if group.CreatorId == accountName {
+			return true
+		}
+		if group.Hidden {
+			return false
+		}
+		// Deleted groups no longer have applications
+		return applied[group.Id] || slices.Contains(accounts, accountName)
+	})
+}
+
+// calendar builds a feed of the scheduled groups that haven't ended, and
+// cancellations of those deleted before they started. include decides which
+// groups are in the feed, accounts is only set for deleted groups.
+func (s *Server) calendar(ctx context.Context, name string, now time.Time, include func(group *pb.Group, accounts []string) bool) (*ical.Calendar, error) {
+	groups, err := s.db.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tombstones, err := s.db.ListTombstones(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &ical.Calendar{ProdID: "-//gw2lfg//gw2lfgserver//EN", Name: name}
+	for _, group := range groups {
+		if group.StartAtSec == 0 || !s.eventEnd(group).After(now) || !include(group, nil) {
+			continue
+		}
+		calendar.Events = append(calendar.Events, s.groupEvent(group, ical.StatusConfirmed))
+	}
+	for _, tombstone := range tombstones {
+		if !include(tombstone.Group, tombstone.Accounts) {
+			continue
+		}
+		event := s.groupEvent(tombstone.Group, ical.StatusCancelled)
+		event.LastModified = tombstone.DeletedAt
+		// A group deleted within a second of its last update still has to
+		// supersede it
+		event.Sequence = max(sequence(tombstone.Group, tombstone.DeletedAt), event.Sequence+1)
+		calendar.Events = append(calendar.Events, event)
+	}
+	return calendar, nil
+}
+
+func (s *Server) eventEnd(group *pb.Group) time.Time {
+	duration := s.config.CloseAfter
+	if duration <= 0 {
+		duration = defaultEventDuration
+	}
+	return time.Unix(group.StartAtSec, 0).Add(duration)
+}
+
+func (s *Server) groupEvent(group *pb.Group, eventStatus ical.Status) ical.Event {
+	event := ical.Event{
+		UID:          group.Id + "@gw2lfg",
+		Status:       eventStatus,
+		Summary:      group.Title,
+		Description:  fmt.Sprintf("Commander: %s", group.CreatorId),
+		Start:        time.Unix(group.StartAtSec, 0),
+		End:          s.eventEnd(group),
+		LastModified: modifiedAt(group),
+	}
+	event.Sequence = sequence(group, event.LastModified)
+	return event
+}
+
+// modifiedAt returns when group last changed, its creation for groups saved
+// before changes were tracked.
+func modifiedAt(group *pb.Group) time.Time {
+	if group.ModifiedAtSec == 0 {
+		return time.Unix(group.CreatedAtSec, 0)
+	}
+	return time.Unix(group.ModifiedAtSec, 0)
+}
+
+// sequence numbers the revisions of a group's event by the seconds since its
+// creation, so every update or cancellation at modified supersedes the
+// event clients already have.
+func sequence(group *pb.Group, modified time.Time) int64 {
+	return max(modified.Unix()-group.CreatedAtSec, 0)
+}
+
+// recordCancellation keeps a scheduled group that is about to be deleted
+// until its start, so calendar feeds can cancel its event. It must run
+// before db.DeleteGroup, which deletes the applications as well.
+func (s *Server) recordCancellation(ctx context.Context, group *pb.Group) {
+	if group.StartAtSec == 0 || time.Unix(group.StartAtSec, 0).Before(time.Now()) {
+		return
+	}
+	applications, err := s.db.ListApplicationsForGroup(ctx, group.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListApplicationsForGroup", "err", err)
+		return
+	}
+	accounts := []string{group.CreatorId}
+	for _, application := range applications {
+		if application.Status != pb.ApplicationStatus_APPLICATION_STATUS_DECLINED {
+			accounts = append(accounts, application.AccountName)
+		}
+	}
+	err = s.db.SaveTombstone(ctx, database.Tombstone{
+		Group:     group,
+		Accounts:  accounts,
+		DeletedAt: time.Now(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveTombstone", "err", err)
+	}
+}
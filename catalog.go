@@ -0,0 +1,78 @@
+package main
+
+import (
+	pb "gw2lfgserver/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eliteSpecProfessions maps each elite specialization to its profession.
+var eliteSpecProfessions = map[pb.EliteSpec]pb.Profession{
+	pb.EliteSpec_ELITE_SPEC_DRAGONHUNTER: pb.Profession_PROFESSION_GUARDIAN,
+	pb.EliteSpec_ELITE_SPEC_FIREBRAND:    pb.Profession_PROFESSION_GUARDIAN,
+	pb.EliteSpec_ELITE_SPEC_WILLBENDER:   pb.Profession_PROFESSION_GUARDIAN,
+	pb.EliteSpec_ELITE_SPEC_BERSERKER:    pb.Profession_PROFESSION_WARRIOR,
+	pb.EliteSpec_ELITE_SPEC_SPELLBREAKER: pb.Profession_PROFESSION_WARRIOR,
+	pb.EliteSpec_ELITE_SPEC_BLADESWORN:   pb.Profession_PROFESSION_WARRIOR,
+	pb.EliteSpec_ELITE_SPEC_SCRAPPER:     pb.Profession_PROFESSION_ENGINEER,
+	pb.EliteSpec_ELITE_SPEC_HOLOSMITH:    pb.Profession_PROFESSION_ENGINEER,
+	pb.EliteSpec_ELITE_SPEC_MECHANIST:    pb.Profession_PROFESSION_ENGINEER,
+	pb.EliteSpec_ELITE_SPEC_DRUID:        pb.Profession_PROFESSION_RANGER,
+	pb.EliteSpec_ELITE_SPEC_SOULBEAST:    pb.Profession_PROFESSION_RANGER,
+	pb.EliteSpec_ELITE_SPEC_UNTAMED:      pb.Profession_PROFESSION_RANGER,
+	pb.EliteSpec_ELITE_SPEC_DAREDEVIL:    pb.Profession_PROFESSION_THIEF,
+	pb.EliteSpec_ELITE_SPEC_DEADEYE:      pb.Profession_PROFESSION_THIEF,
+	pb.EliteSpec_ELITE_SPEC_SPECTER:      pb.Profession_PROFESSION_THIEF,
+	pb.EliteSpec_ELITE_SPEC_TEMPEST:      pb.Profession_PROFESSION_ELEMENTALIST,
+	pb.EliteSpec_ELITE_SPEC_WEAVER:       pb.Profession_PROFESSION_ELEMENTALIST,
+	pb.EliteSpec_ELITE_SPEC_CATALYST:     pb.Profession_PROFESSION_ELEMENTALIST,
+	pb.EliteSpec_ELITE_SPEC_CHRONOMANCER: pb.Profession_PROFESSION_MESMER,
+	pb.EliteSpec_ELITE_SPEC_MIRAGE:       pb.Profession_PROFESSION_MESMER,
+	pb.EliteSpec_ELITE_SPEC_VIRTUOSO:     pb.Profession_PROFESSION_MESMER,
+	pb.EliteSpec_ELITE_SPEC_REAPER:       pb.Profession_PROFESSION_NECROMANCER,
+	pb.EliteSpec_ELITE_SPEC_SCOURGE:      pb.Profession_PROFESSION_NECROMANCER,
+	pb.EliteSpec_ELITE_SPEC_HARBINGER:    pb.Profession_PROFESSION_NECROMANCER,
+	pb.EliteSpec_ELITE_SPEC_HERALD:       pb.Profession_PROFESSION_REVENANT,
+	pb.EliteSpec_ELITE_SPEC_RENEGADE:     pb.Profession_PROFESSION_REVENANT,
+	pb.EliteSpec_ELITE_SPEC_VINDICATOR:   pb.Profession_PROFESSION_REVENANT,
+}
+
+// validateLoadout checks what an applicant says they bring. Everything is
+// optional, but has to be consistent.
+func validateLoadout(application *pb.GroupApplication) error {
+	if _, ok := pb.Role_name[int32(application.Role)]; !ok {
+		return status.Error(codes.InvalidArgument, "Unknown role")
+	}
+	if _, ok := pb.Boon_name[int32(application.Boon)]; !ok {
+		return status.Error(codes.InvalidArgument, "Unknown boon")
+	}
+	if _, ok := pb.Profession_name[int32(application.Profession)]; !ok {
+		return status.Error(codes.InvalidArgument, "Unknown profession")
+	}
+
+	switch application.Role {
+	case pb.Role_ROLE_BOON_DPS:
+		if application.Boon == pb.Boon_BOON_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "Boon DPS requires a boon")
+		}
+	case pb.Role_ROLE_HEAL:
+	default:
+		if application.Boon != pb.Boon_BOON_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "Only healers and boon DPS provide a boon")
+		}
+	}
+
+	if application.EliteSpec != pb.EliteSpec_ELITE_SPEC_UNSPECIFIED {
+		profession, ok := eliteSpecProfessions[application.EliteSpec]
+		if !ok {
+			return status.Error(codes.InvalidArgument, "Unknown elite specialization")
+		}
+		if application.Profession == pb.Profession_PROFESSION_UNSPECIFIED {
+			application.Profession = profession
+		} else if profession != application.Profession {
+			return status.Error(codes.InvalidArgument, "Elite specialization does not belong to the profession")
+		}
+	}
+	return nil
+}
@@ -1,11 +1,41 @@
 package clientinfo
 
-import "context"
+import (
+	"context"
+	"slices"
+	"time"
+)
 
 type clientInfoContextKey struct{}
 type ClientInfo struct {
 	AccountName string
-	Token       string
+	// TokenHash identifies the credential the client authenticated with,
+	// without keeping the credential itself around.
+	TokenHash string
+	// Permissions granted to the API key, e.g. "account", "progression" or "characters".
+	Permissions []string
+	Profile     *Profile
+	// Admin is set for accounts configured as server admins.
+	Admin bool
+}
+
+// Profile is the account information returned by the GW2 API.
+type Profile struct {
+	World       int       `json:"world,omitempty"`
+	Guilds      []string  `json:"guilds,omitempty"`
+	GuildLeader []string  `json:"guild_leader,omitempty"` // Requires the guilds permission
+	Access      []string  `json:"access,omitempty"`
+	Commander   bool      `json:"commander,omitempty"`
+	Created     time.Time `json:"created"`
+	// Age is the time played on the account.
+	Age          time.Duration `json:"age,omitempty"`
+	FractalLevel int           `json:"fractal_level,omitempty"` // Requires the progression permission
+	WvwTeamID    int           `json:"wvw_team_id,omitempty"`
+}
+
+// HasPermission reports whether the client's API key grants the given permission.
+func (c *ClientInfo) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
 }
 
 func ToContext(ctx context.Context, info *ClientInfo) context.Context {
@@ -14,7 +44,7 @@ func ToContext(ctx context.Context, info *ClientInfo) context.Context {
 
 func FromContext(ctx context.Context) *ClientInfo {
 	info, ok := ctx.Value(clientInfoContextKey{}).(*ClientInfo)
-	if ok && info != nil && info.AccountName != "" && info.Token != "" {
+	if ok && info != nil && info.AccountName != "" && info.TokenHash != "" {
 		return info
 	} else {
 		return nil
@@ -0,0 +1,266 @@
+package contentpolicy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rule names a check of the policy.
+type Rule string
+
+const (
+	RuleLength      Rule = "length"
+	RuleCharacters  Rule = "characters"
+	RuleBlocklist   Rule = "blocklist"
+	RuleURL         Rule = "url"
+	RuleGoldSelling Rule = "gold_selling"
+)
+
+// Action decides what happens to text that breaks a rule.
+type Action int
+
+const (
+	// Reject refuses the text.
+	Reject Action = iota
+	// Flag accepts the text but reports it for moderation.
+	Flag
+)
+
+var (
+	urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|io|gg|co|xyz|ru|cn|shop|store|top|me|ly)\b`)
+	// Selling raids for in-game gold is fine, selling gold for real money is not
+	goldSellingPattern = regexp.MustCompile(`(?i)\b(?:buy|cheap|sell(?:ing)?)\s+(?:gw2\s+)?gold\b|\bgold\s+for\s+sale\b|\bpaypal\b|\brmt\b|[$€£]\s*\d|\b\d+\s*(?:usd|eur|gbp)\b`)
+)
+
+// Config holds configuration for the content policy
+type Config struct {
+	// MaxLength is the maximum length in characters.
+	MaxLength int
+	// MaxRepeat is the maximum number of times a character may repeat in a
+	// row, to stop "!!!!!!!!!!!" style titles.
+	MaxRepeat int
+	// BlocklistPath points to a file with one blocked word per line, or a
+	// regular expression prefixed with "re:". Lines starting with # are
+	// ignored. Empty disables the blocklist.
+	BlocklistPath string
+	// Actions overrides the default action of Reject per rule.
+	Actions map[Rule]Action
+}
+
+// Violation describes a broken rule.
+type Violation struct {
+	Rule   Rule
+	Action Action
+	Detail string
+}
+
+// Verdict is the result of checking a text.
+type Verdict struct {
+	// Rejected is set if the text must not be accepted.
+	Rejected *Violation
+	// Flagged lists violations that should be reported for moderation.
+	Flagged []Violation
+}
+
+// Policy checks user provided text such as group titles.
+type Policy struct {
+	cfg Config
+
+	mu        sync.RWMutex
+	blocklist []*regexp.Regexp
+	modTime   time.Time
+}
+
+// New creates a policy and loads its blocklist.
+func New(cfg Config) (*Policy, error) {
+	p := &Policy{cfg: cfg}
+	if cfg.BlocklistPath != "" {
+		if err := p.reload(); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ParseActions parses a comma separated list of rule=action pairs, e.g.
+// "url=flag,blocklist=reject".
+func ParseActions(s string) (map[Rule]Action, error) {
+	actions := make(map[Rule]Action)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		rule, action, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected rule=action, got %q", pair)
+		}
+		switch Rule(rule) {
+		case RuleLength, RuleCharacters, RuleBlocklist, RuleURL, RuleGoldSelling:
+		default:
+			return nil, fmt.Errorf("unknown rule %q", rule)
+		}
+		switch action {
+		case "reject":
+			actions[Rule(rule)] = Reject
+		case "flag":
+			actions[Rule(rule)] = Flag
+		default:
+			return nil, fmt.Errorf("unknown action %q", action)
+		}
+	}
+	return actions, nil
+}
+
+// Check runs all rules against text.
+func (p *Policy) Check(text string) Verdict {
+	var verdict Verdict
+	for _, v := range p.violations(text) {
+		if v.Action == Reject {
+			verdict.Rejected = &v
+			return verdict
+		}
+		verdict.Flagged = append(verdict.Flagged, v)
+	}
+	return verdict
+}
+
+func (p *Policy) violations(text string) []Violation {
+	var violations []Violation
+	add := func(rule Rule, detail string) {
+		violations = append(violations, Violation{Rule: rule, Action: p.cfg.Actions[rule], Detail: detail})
+	}
+
+	if p.cfg.MaxLength > 0 && utf8.RuneCountInString(text) > p.cfg.MaxLength {
+		add(RuleLength, fmt.Sprintf("must be at most %d characters", p.cfg.MaxLength))
+	}
+	if detail := checkCharacters(text, p.cfg.MaxRepeat); detail != "" {
+		add(RuleCharacters, detail)
+	}
+	if p.blocked(text) {
+		add(RuleBlocklist, "contains blocked words")
+	}
+	if urlPattern.MatchString(text) {
+		add(RuleURL, "must not contain links")
+	}
+	if goldSellingPattern.MatchString(text) {
+		add(RuleGoldSelling, "must not advertise real money trading")
+	}
+	return violations
+}
+
+func checkCharacters(text string, maxRepeat int) string {
+	if !utf8.ValidString(text) {
+		return "must be valid UTF-8"
+	}
+	var last rune
+	repeat := 0
+	for _, r := range text {
+		// Control and format characters include newlines and zero width
+		// characters used to sneak past the blocklist
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) || !unicode.IsPrint(r) && r != ' ' {
+			return "must not contain control or invisible characters"
+		}
+		if r == last {
+			repeat++
+		} else {
+			last, repeat = r, 1
+		}
+		if maxRepeat > 0 && repeat > maxRepeat {
+			return fmt.Sprintf("must not repeat a character more than %d times", maxRepeat)
+		}
+	}
+	return ""
+}
+
+func (p *Policy) blocked(text string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, re := range p.blocklist {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the blocklist whenever its file changes, until ctx is done.
+func (p *Policy) Watch(ctx context.Context, interval time.Duration) {
+	if p.cfg.BlocklistPath == "" {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := p.reload(); err != nil {
+				// Keep the previous blocklist rather than allowing everything
+				slog.ErrorContext(ctx, "p.reload", "err", err)
+			}
+		}
+	}
+}
+
+func (p *Policy) reload() error {
+	info, err := os.Stat(p.cfg.BlocklistPath)
+	if err != nil {
+		return err
+	}
+	p.mu.RLock()
+	unchanged := info.ModTime().Equal(p.modTime)
+	p.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	blocklist, err := loadBlocklist(p.cfg.BlocklistPath)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.blocklist = blocklist
+	p.modTime = info.ModTime()
+	p.mu.Unlock()
+	slog.Info("Loaded content blocklist", "path", p.cfg.BlocklistPath, "entries", len(blocklist))
+	return nil
+}
+
+func loadBlocklist(path string) ([]*regexp.Regexp, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var blocklist []*regexp.Regexp
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		pattern := `(?i)\b` + regexp.QuoteMeta(entry) + `\b`
+		if expr, ok := strings.CutPrefix(entry, "re:"); ok {
+			pattern = "(?i)" + expr
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		blocklist = append(blocklist, re)
+	}
+	return blocklist, scanner.Err()
+}
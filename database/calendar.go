@@ -0,0 +1,123 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+// GetCalendarToken returns the secret of an account's calendar feed, or ""
+// if it has none yet.
+func (db *DB) GetCalendarToken(ctx context.Context, accountName string) (string, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetCalendarToken", slog.Duration("elapsed", time.Since(start))) }()
+	var token string
+	err := db.db.QueryRowContext(ctx, `SELECT token FROM calendar_feeds WHERE account_name = ?`, accountName).Scan(&token)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return token, err
+}
+
+// SaveCalendarToken sets the secret of an account's calendar feed,
+// invalidating the previous one.
+func (db *DB) SaveCalendarToken(ctx context.Context, accountName, token string, t time.Time) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveCalendarToken", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `
+		INSERT INTO calendar_feeds (account_name, token, created_at_sec)
+		VALUES (?, ?, ?)
+		ON CONFLICT(account_name) DO UPDATE SET
+			token = excluded.token,
+			created_at_sec = excluded.created_at_sec`,
+		accountName, token, t.Unix())
+	return err
+}
+
+// GetCalendarAccount returns the account owning a calendar feed secret, or
+// "" if it is unknown.
+func (db *DB) GetCalendarAccount(ctx context.Context, token string) (string, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetCalendarAccount", slog.Duration("elapsed", time.Since(start))) }()
+	var accountName string
+	err := db.db.QueryRowContext(ctx, `SELECT account_name FROM calendar_feeds WHERE token = ?`, token).Scan(&accountName)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return accountName, err
+}
+
+// Tombstone is a scheduled group that was deleted before it started, kept
+// so calendar feeds can cancel its event.
+type Tombstone struct {
+	Group *pb.Group
+	// Accounts are the creator and applicants at the time of deletion.
+	Accounts  []string
+	DeletedAt time.Time
+}
+
+func (db *DB) SaveTombstone(ctx context.Context, tombstone Tombstone) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveTombstone", slog.Duration("elapsed", time.Since(start))) }()
+	group, err := marshalMessage(tombstone.Group)
+	if err != nil {
+		return err
+	}
+	accounts, err := marshalStrings(tombstone.Accounts)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO group_tombstones (group_id, group_snapshot, accounts, start_at_sec, deleted_at_sec)
+		VALUES (?, ?, ?, ?, ?)`,
+		tombstone.Group.Id, group, accounts, tombstone.Group.StartAtSec, tombstone.DeletedAt.Unix())
+	return err
+}
+
+// ListTombstones returns tombstones of groups that would have started
+// after t.
+func (db *DB) ListTombstones(ctx context.Context, t time.Time) ([]Tombstone, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ListTombstones", slog.Duration("elapsed", time.Since(start))) }()
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT group_snapshot, accounts, deleted_at_sec
+		FROM group_tombstones
+		WHERE start_at_sec > ?
+		ORDER BY start_at_sec`, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tombstones []Tombstone
+	for rows.Next() {
+		var snapshot, accounts sql.NullString
+		var deletedAt int64
+		if err := rows.Scan(&snapshot, &accounts, &deletedAt); err != nil {
+			return nil, err
+		}
+		var group pb.Group
+		if _, err := unmarshalMessage(snapshot, &group); err != nil {
+			return nil, err
+		}
+		tombstone := Tombstone{Group: &group, DeletedAt: time.Unix(deletedAt, 0)}
+		if tombstone.Accounts, err = unmarshalStrings(accounts); err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, tombstone)
+	}
+	return tombstones, rows.Err()
+}
+
+// DeleteTombstonesBefore deletes tombstones of groups that would have
+// started before t.
+func (db *DB) DeleteTombstonesBefore(ctx context.Context, t time.Time) error {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.DeleteTombstonesBefore", slog.Duration("elapsed", time.Since(start)))
+	}()
+	_, err := db.db.ExecContext(ctx, `DELETE FROM group_tombstones WHERE start_at_sec < ?`, t.Unix())
+	return err
+}
@@ -3,11 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	pb "gw2lfgserver/pb"
 	"log/slog"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // DB represents a database connection with CRUD operations for groups and applications
@@ -43,7 +47,20 @@ func initializeTables(db *sql.DB) error {
 			kill_proof_id TEXT,
 			kill_proof_minimum INTEGER DEFAULT 0,
 			created_at_sec INTEGER NOT NULL,
-			updated_at_sec INTEGER NOT NULL
+			updated_at_sec INTEGER NOT NULL,
+			required_access TEXT,
+			require_commander INTEGER DEFAULT 0,
+			min_fractal_level INTEGER DEFAULT 0,
+			min_account_age_sec INTEGER DEFAULT 0,
+			visibility INTEGER DEFAULT 0,
+			guild_ids TEXT,
+			invite_code TEXT,
+			hidden INTEGER DEFAULT 0,
+			start_at_sec INTEGER DEFAULT 0,
+			state INTEGER DEFAULT 0,
+			slots TEXT,
+			auto_accept TEXT,
+			modified_at_sec INTEGER DEFAULT 0
 		);
 
 		CREATE TABLE IF NOT EXISTS applications (
@@ -52,32 +69,375 @@ func initializeTables(db *sql.DB) error {
 			account_name TEXT NOT NULL,
 			created_at_sec INTEGER NOT NULL,
 			updated_at_sec INTEGER NOT NULL,
+			applicant_profile TEXT,
+			role INTEGER DEFAULT 0,
+			boon INTEGER DEFAULT 0,
+			profession INTEGER DEFAULT 0,
+			elite_spec INTEGER DEFAULT 0,
+			note TEXT,
+			status INTEGER DEFAULT 0,
+			slot_id TEXT,
 			FOREIGN KEY(group_id) REFERENCES groups(id) ON DELETE CASCADE
 		);
 
+		CREATE TABLE IF NOT EXISTS invitations (
+			id TEXT PRIMARY KEY,
+			group_id TEXT NOT NULL,
+			commander TEXT NOT NULL,
+			account_name TEXT NOT NULL,
+			created_at_sec INTEGER NOT NULL,
+			expires_at_sec INTEGER NOT NULL,
+			UNIQUE(group_id, account_name),
+			FOREIGN KEY(group_id) REFERENCES groups(id) ON DELETE CASCADE
+		);
+
+		CREATE TABLE IF NOT EXISTS group_templates (
+			id TEXT PRIMARY KEY,
+			owner_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			group_settings TEXT,
+			recurrence TEXT,
+			lead_time_sec INTEGER DEFAULT 0,
+			created_at_sec INTEGER NOT NULL,
+			updated_at_sec INTEGER NOT NULL,
+			last_start_at_sec INTEGER DEFAULT 0
+		);
+
+		CREATE TABLE IF NOT EXISTS calendar_feeds (
+			account_name TEXT PRIMARY KEY,
+			token TEXT NOT NULL UNIQUE,
+			created_at_sec INTEGER NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS group_tombstones (
+			group_id TEXT PRIMARY KEY,
+			group_snapshot TEXT,
+			accounts TEXT,
+			start_at_sec INTEGER NOT NULL,
+			deleted_at_sec INTEGER NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS bans (
+			account_name TEXT PRIMARY KEY,
+			reason TEXT,
+			banned_by TEXT NOT NULL,
+			created_at_sec INTEGER NOT NULL,
+			expires_at_sec INTEGER DEFAULT 0
+		);
+
+		CREATE TABLE IF NOT EXISTS blocks (
+			commander TEXT NOT NULL,
+			account_name TEXT NOT NULL,
+			created_at_sec INTEGER NOT NULL,
+			PRIMARY KEY (commander, account_name)
+		);
+
+		CREATE TABLE IF NOT EXISTS whitelist (
+			commander TEXT NOT NULL,
+			account_name TEXT NOT NULL,
+			created_at_sec INTEGER NOT NULL,
+			PRIMARY KEY (commander, account_name)
+		);
+
+		CREATE TABLE IF NOT EXISTS reports (
+			id TEXT PRIMARY KEY,
+			reporter TEXT NOT NULL,
+			target_type INTEGER NOT NULL,
+			target_id TEXT NOT NULL,
+			target_account_name TEXT NOT NULL,
+			reason TEXT,
+			snapshot TEXT,
+			created_at_sec INTEGER NOT NULL,
+			resolved INTEGER DEFAULT 0,
+			resolved_by TEXT,
+			resolution TEXT,
+			resolved_at_sec INTEGER DEFAULT 0
+		);
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open ON reports(reporter, target_type, target_id) WHERE resolved = 0;
+
+		CREATE TABLE IF NOT EXISTS audit_log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			admin TEXT NOT NULL,
+			action TEXT NOT NULL,
+			target TEXT NOT NULL,
+			reason TEXT,
+			created_at_sec INTEGER NOT NULL
+		);
+
 		CREATE INDEX IF NOT EXISTS idx_groups_creator ON groups(creator_id);
+		CREATE INDEX IF NOT EXISTS idx_invitations_account ON invitations(account_name);
+		CREATE INDEX IF NOT EXISTS idx_group_templates_owner ON group_templates(owner_id);
 		CREATE INDEX IF NOT EXISTS idx_applications_group ON applications(group_id);
 	`
-	_, err := db.Exec(schema)
+	if _, err := db.Exec(schema); err != nil {
+		return err
+	}
+	if err := migrate(db); err != nil {
+		return err
+	}
+	// Indexes on migrated columns can only be created afterwards
+	_, err := db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_groups_invite_code ON groups(invite_code) WHERE invite_code IS NOT NULL`)
 	return err
 }
 
+// migrations add columns that were introduced after a table was first
+// created, so existing database files keep working.
+var migrations = []struct {
+	table, column, definition string
+}{
+	{"applications", "applicant_profile", "TEXT"},
+	{"applications", "role", "INTEGER DEFAULT 0"},
+	{"applications", "boon", "INTEGER DEFAULT 0"},
+	{"applications", "profession", "INTEGER DEFAULT 0"},
+	{"applications", "elite_spec", "INTEGER DEFAULT 0"},
+	{"applications", "note", "TEXT"},
+	{"applications", "status", "INTEGER DEFAULT 0"},
+	{"applications", "slot_id", "TEXT"},
+	{"groups", "required_access", "TEXT"},
+	{"groups", "require_commander", "INTEGER DEFAULT 0"},
+	{"groups", "min_fractal_level", "INTEGER DEFAULT 0"},
+	{"groups", "min_account_age_sec", "INTEGER DEFAULT 0"},
+	{"groups", "visibility", "INTEGER DEFAULT 0"},
+	{"groups", "guild_ids", "TEXT"},
+	{"groups", "invite_code", "TEXT"},
+	{"groups", "hidden", "INTEGER DEFAULT 0"},
+	{"groups", "start_at_sec", "INTEGER DEFAULT 0"},
+	{"groups", "state", "INTEGER DEFAULT 0"},
+	{"groups", "slots", "TEXT"},
+	{"groups", "auto_accept", "TEXT"},
+	{"groups", "modified_at_sec", "INTEGER DEFAULT 0"},
+}
+
+func migrate(db *sql.DB) error {
+	for _, m := range migrations {
+		exists, err := columnExists(db, m.table, m.column)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", m.table, m.column, m.definition)); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", m.table, m.column, err)
+		}
+	}
+	return nil
+}
+
+func columnExists(db *sql.DB, table, column string) (bool, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			columnType string
+			notNull    bool
+			defaultVal sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultVal, &primaryKey); err != nil {
+			return false, err
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// marshalMessage encodes an optional message for a TEXT column.
+func marshalMessage(m proto.Message) (sql.NullString, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return sql.NullString{}, nil
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+func unmarshalMessage(s sql.NullString, m proto.Message) (bool, error) {
+	if !s.Valid || s.String == "" {
+		return false, nil
+	}
+	return true, protojson.Unmarshal([]byte(s.String), m)
+}
+
+// marshalMessages encodes a message list for a TEXT column.
+func marshalMessages[M proto.Message](ms []M) (sql.NullString, error) {
+	if len(ms) == 0 {
+		return sql.NullString{}, nil
+	}
+	values := make([]json.RawMessage, len(ms))
+	for i, m := range ms {
+		b, err := protojson.Marshal(m)
+		if err != nil {
+			return sql.NullString{}, err
+		}
+		values[i] = b
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+func unmarshalMessages[M proto.Message](s sql.NullString, newMessage func() M) ([]M, error) {
+	if !s.Valid || s.String == "" {
+		return nil, nil
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(s.String), &values); err != nil {
+		return nil, err
+	}
+	ms := make([]M, len(values))
+	for i, b := range values {
+		ms[i] = newMessage()
+		if err := protojson.Unmarshal(b, ms[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ms, nil
+}
+
+// marshalStrings encodes a string list for a TEXT column.
+func marshalStrings(values []string) (sql.NullString, error) {
+	if len(values) == 0 {
+		return sql.NullString{}, nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+func unmarshalStrings(s sql.NullString) ([]string, error) {
+	if !s.Valid || s.String == "" {
+		return nil, nil
+	}
+	var values []string
+	return values, json.Unmarshal([]byte(s.String), &values)
+}
+
 // GroupOperations contains all group-related database operations
+const groupColumns = `id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec,
+	required_access, require_commander, min_fractal_level, min_account_age_sec,
+	visibility, guild_ids, invite_code, hidden, start_at_sec, state, slots, auto_accept, modified_at_sec`
+
+func scanGroup(s scanner) (*pb.Group, error) {
+	var group pb.Group
+	var requiredAccess, guildIDs, inviteCode, slots, autoAccept sql.NullString
+	if err := s.Scan(
+		&group.Id,
+		&group.CreatorId,
+		&group.Title,
+		&group.KillProofId,
+		&group.KillProofMinimum,
+		&group.CreatedAtSec,
+		&group.UpdatedAtSec,
+		&requiredAccess,
+		&group.RequireCommander,
+		&group.MinFractalLevel,
+		&group.MinAccountAgeSec,
+		&group.Visibility,
+		&guildIDs,
+		&inviteCode,
+		&group.Hidden,
+		&group.StartAtSec,
+		&group.State,
+		&slots,
+		&autoAccept,
+		&group.ModifiedAtSec,
+	); err != nil {
+		return nil, err
+	}
+	var err error
+	if group.RequiredAccess, err = unmarshalStrings(requiredAccess); err != nil {
+		return nil, err
+	}
+	if group.GuildIds, err = unmarshalStrings(guildIDs); err != nil {
+		return nil, err
+	}
+	group.InviteCode = inviteCode.String
+	if group.Slots, err = unmarshalMessages(slots, func() *pb.RoleSlot { return &pb.RoleSlot{} }); err != nil {
+		return nil, err
+	}
+	var settings pb.AutoAccept
+	if ok, err := unmarshalMessage(autoAccept, &settings); err != nil {
+		return nil, err
+	} else if ok {
+		group.AutoAccept = &settings
+	}
+	return &group, nil
+}
+
+func scanGroups(rows *sql.Rows) ([]*pb.Group, error) {
+	var groups []*pb.Group
+	for rows.Next() {
+		group, err := scanGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
 func (db *DB) SaveGroup(ctx context.Context, group *pb.Group) (*pb.Group, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.SaveGroup", slog.Duration("elapsed", time.Since(start))) }()
 	query := `
-        INSERT INTO groups (id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec)
-        VALUES (?, ?, ?, ?, ?, ?, ?)
+        INSERT INTO groups (` + groupColumns + `)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
         ON CONFLICT(id) DO UPDATE SET
             title = excluded.title,
             kill_proof_id = excluded.kill_proof_id,
             kill_proof_minimum = excluded.kill_proof_minimum,
-			updated_at_sec = excluded.updated_at_sec
-        RETURNING id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec
-    `
-	var savedGroup pb.Group
-	err := db.db.QueryRowContext(ctx, query,
+			updated_at_sec = excluded.updated_at_sec,
+			required_access = excluded.required_access,
+			require_commander = excluded.require_commander,
+			min_fractal_level = excluded.min_fractal_level,
+			min_account_age_sec = excluded.min_account_age_sec,
+			visibility = excluded.visibility,
+			guild_ids = excluded.guild_ids,
+			invite_code = excluded.invite_code,
+			start_at_sec = excluded.start_at_sec,
+			state = excluded.state,
+			slots = excluded.slots,
+			auto_accept = excluded.auto_accept,
+			modified_at_sec = excluded.modified_at_sec
+        RETURNING ` + groupColumns
+	requiredAccess, err := marshalStrings(group.RequiredAccess)
+	if err != nil {
+		return nil, err
+	}
+	guildIDs, err := marshalStrings(group.GuildIds)
+	if err != nil {
+		return nil, err
+	}
+	inviteCode := sql.NullString{String: group.InviteCode, Valid: group.InviteCode != ""}
+	slots, err := marshalMessages(group.Slots)
+	if err != nil {
+		return nil, err
+	}
+	autoAccept, err := marshalMessage(group.AutoAccept)
+	if err != nil {
+		return nil, err
+	}
+	return scanGroup(db.db.QueryRowContext(ctx, query,
 		group.Id,
 		group.CreatorId,
 		group.Title,
@@ -85,93 +445,188 @@ func (db *DB) SaveGroup(ctx context.Context, group *pb.Group) (*pb.Group, error)
 		group.KillProofMinimum,
 		group.CreatedAtSec,
 		group.UpdatedAtSec,
-	).Scan(
-		&savedGroup.Id,
-		&savedGroup.CreatorId,
-		&savedGroup.Title,
-		&savedGroup.KillProofId,
-		&savedGroup.KillProofMinimum,
-		&savedGroup.CreatedAtSec,
-		&savedGroup.UpdatedAtSec,
-	)
+		requiredAccess,
+		group.RequireCommander,
+		group.MinFractalLevel,
+		group.MinAccountAgeSec,
+		group.Visibility,
+		guildIDs,
+		inviteCode,
+		group.Hidden,
+		group.StartAtSec,
+		group.State,
+		slots,
+		autoAccept,
+		group.ModifiedAtSec,
+	))
+}
+
+// SetGroupState moves a group to a new lifecycle state at t.
+func (db *DB) SetGroupState(ctx context.Context, id string, state pb.GroupState, t time.Time) (*pb.Group, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SetGroupState", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		UPDATE groups
+		SET state = ?, modified_at_sec = ?
+		WHERE id = ?
+		RETURNING ` + groupColumns
+	group, err := scanGroup(db.db.QueryRowContext(ctx, query, state, t.Unix(), id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return group, err
+}
+
+// SetGroupSlots replaces the role slots of a group, e.g. to update how many
+// of them are filled.
+func (db *DB) SetGroupSlots(ctx context.Context, id string, slots []*pb.RoleSlot) (*pb.Group, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SetGroupSlots", slog.Duration("elapsed", time.Since(start))) }()
+	value, err := marshalMessages(slots)
 	if err != nil {
 		return nil, err
 	}
-	return &savedGroup, nil
+	query := `
+		UPDATE groups
+		SET slots = ?
+		WHERE id = ?
+		RETURNING ` + groupColumns
+	group, err := scanGroup(db.db.QueryRowContext(ctx, query, value, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return group, err
 }
 
-func (db *DB) DeleteGroup(ctx context.Context, groupId string) error {
+// SetGroupHidden hides or unhides a group. SaveGroup never changes this, so
+// creators can't unhide their own groups.
+func (db *DB) SetGroupHidden(ctx context.Context, id string, hidden bool) (*pb.Group, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SetGroupHidden", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		UPDATE groups
+		SET hidden = ?
+		WHERE id = ?
+		RETURNING ` + groupColumns
+	group, err := scanGroup(db.db.QueryRowContext(ctx, query, hidden, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return group, err
+}
+
+// DeleteGroupResult holds what was deleted along with a group.
+type DeleteGroupResult struct {
+	Applications []*pb.GroupApplication
+	Invitations  []*pb.Invitation
+}
+
+// DeleteGroup deletes a group together with its applications and
+// invitations. Foreign keys are not enforced, so nothing cascades on its own.
+func (db *DB) DeleteGroup(ctx context.Context, groupId string) (*DeleteGroupResult, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.DeleteGroup", slog.Duration("elapsed", time.Since(start))) }()
-	_, err := db.db.ExecContext(ctx, `DELETE FROM groups WHERE id = ?`, groupId)
-	return err
+
+	result := &DeleteGroupResult{}
+
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM applications
+		WHERE group_id = ?
+		RETURNING `+applicationColumns, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if result.Applications, err = scanApplications(rows); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, `
+		DELETE FROM invitations
+		WHERE group_id = ?
+		RETURNING `+invitationColumns, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if result.Invitations, err = scanInvitations(rows); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM groups WHERE id = ?`, groupId); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func (db *DB) DeleteGroupsUpdatedBefore(ctx context.Context, t time.Time) ([]*pb.Group, error) {
+// DeleteGroupsExpiredBefore deletes groups that were neither updated nor
+// scheduled to start after t.
+func (db *DB) DeleteGroupsExpiredBefore(ctx context.Context, t time.Time) ([]*pb.Group, error) {
 	start := time.Now()
 	defer func() {
-		slog.InfoContext(ctx, "db.DeleteGroupsUpdatedBefore", slog.Duration("elapsed", time.Since(start)))
+		slog.InfoContext(ctx, "db.DeleteGroupsExpiredBefore", slog.Duration("elapsed", time.Since(start)))
 	}()
 	query := `
         DELETE FROM groups 
-        WHERE updated_at_sec < ? 
-        RETURNING id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec
-    `
+        WHERE MAX(updated_at_sec, start_at_sec) < ? 
+        RETURNING ` + groupColumns
 	rows, err := db.db.QueryContext(ctx, query, t.Unix())
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var deletedGroups []*pb.Group
-	for rows.Next() {
-		var group pb.Group
-		if err := rows.Scan(
-			&group.Id,
-			&group.CreatorId,
-			&group.Title,
-			&group.KillProofId,
-			&group.KillProofMinimum,
-			&group.CreatedAtSec,
-			&group.UpdatedAtSec,
-		); err != nil {
-			return nil, err
-		}
-		deletedGroups = append(deletedGroups, &group)
-	}
-
-	return deletedGroups, rows.Err()
+	return scanGroups(rows)
 }
 
 func (db *DB) GetGroup(ctx context.Context, id string) (*pb.Group, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.GetGroup", slog.Duration("elapsed", time.Since(start))) }()
 	query := `
-		SELECT id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec
+		SELECT ` + groupColumns + `
 		FROM groups 
 		WHERE id = ?
 	`
-	var group pb.Group
-	err := db.db.QueryRowContext(ctx, query, id).Scan(
-		&group.Id,
-		&group.CreatorId,
-		&group.Title,
-		&group.KillProofId,
-		&group.KillProofMinimum,
-		&group.CreatedAtSec,
-		&group.UpdatedAtSec,
-	)
+	group, err := scanGroup(db.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
-	return &group, err
+	return group, err
+}
+
+func (db *DB) GetGroupByInviteCode(ctx context.Context, inviteCode string) (*pb.Group, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetGroupByInviteCode", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		SELECT ` + groupColumns + `
+		FROM groups 
+		WHERE invite_code = ?
+	`
+	group, err := scanGroup(db.db.QueryRowContext(ctx, query, inviteCode))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return group, err
 }
 
 func (db *DB) ListGroups(ctx context.Context) ([]*pb.Group, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.ListGroups", slog.Duration("elapsed", time.Since(start))) }()
 	query := `
-		SELECT id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec
+		SELECT ` + groupColumns + `
 		FROM groups 
 		ORDER BY updated_at_sec DESC
 	`
@@ -181,73 +636,104 @@ func (db *DB) ListGroups(ctx context.Context) ([]*pb.Group, error) {
 	}
 	defer rows.Close()
 
-	var groups []*pb.Group
+	return scanGroups(rows)
+}
+
+// ApplicationOperations contains all application-related database operations
+const applicationColumns = `id, group_id, account_name, created_at_sec, updated_at_sec, applicant_profile,
+	role, boon, profession, elite_spec, note, status, slot_id`
+
+func scanApplication(s scanner) (*pb.GroupApplication, error) {
+	var app pb.GroupApplication
+	var profile, note, slotID sql.NullString
+	if err := s.Scan(
+		&app.Id,
+		&app.GroupId,
+		&app.AccountName,
+		&app.CreatedAtSec,
+		&app.UpdatedAtSec,
+		&profile,
+		&app.Role,
+		&app.Boon,
+		&app.Profession,
+		&app.EliteSpec,
+		&note,
+		&app.Status,
+		&slotID,
+	); err != nil {
+		return nil, err
+	}
+	app.Note = note.String
+	app.SlotId = slotID.String
+	var applicantProfile pb.AccountProfile
+	if ok, err := unmarshalMessage(profile, &applicantProfile); err != nil {
+		return nil, err
+	} else if ok {
+		app.ApplicantProfile = &applicantProfile
+	}
+	return &app, nil
+}
+
+func scanApplications(rows *sql.Rows) ([]*pb.GroupApplication, error) {
+	var apps []*pb.GroupApplication
 	for rows.Next() {
-		var group pb.Group
-		if err := rows.Scan(
-			&group.Id,
-			&group.CreatorId,
-			&group.Title,
-			&group.KillProofId,
-			&group.KillProofMinimum,
-			&group.CreatedAtSec,
-			&group.UpdatedAtSec,
-		); err != nil {
+		app, err := scanApplication(rows)
+		if err != nil {
 			return nil, err
 		}
-		groups = append(groups, &group)
+		apps = append(apps, app)
 	}
-	return groups, rows.Err()
+	return apps, rows.Err()
 }
 
-// ApplicationOperations contains all application-related database operations
 func (db *DB) SaveApplication(ctx context.Context, app *pb.GroupApplication, groupID string) (*pb.GroupApplication, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.SaveApplication", slog.Duration("elapsed", time.Since(start))) }()
 	query := `
-        INSERT INTO applications (id, group_id, account_name, created_at_sec, updated_at_sec)
-        VALUES (?, ?, ?, ?, ?)
+        INSERT INTO applications (` + applicationColumns + `)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
         ON CONFLICT(id) DO UPDATE SET
             account_name = excluded.account_name,
-			updated_at_sec = excluded.updated_at_sec
-        RETURNING id, group_id, account_name, created_at_sec, updated_at_sec
-    `
-	var savedApp pb.GroupApplication
-	err := db.db.QueryRowContext(ctx, query,
+			updated_at_sec = excluded.updated_at_sec,
+			applicant_profile = excluded.applicant_profile,
+			role = excluded.role,
+			boon = excluded.boon,
+			profession = excluded.profession,
+			elite_spec = excluded.elite_spec,
+			note = excluded.note,
+			status = excluded.status,
+			slot_id = excluded.slot_id
+        RETURNING ` + applicationColumns
+	profile, err := marshalMessage(app.ApplicantProfile)
+	if err != nil {
+		return nil, err
+	}
+	return scanApplication(db.db.QueryRowContext(ctx, query,
 		app.Id,
 		groupID,
 		app.AccountName,
 		app.CreatedAtSec,
 		app.UpdatedAtSec,
-	).Scan(
-		&savedApp.Id,
-		&savedApp.GroupId,
-		&savedApp.AccountName,
-		&savedApp.CreatedAtSec,
-		&savedApp.UpdatedAtSec,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return &savedApp, nil
+		profile,
+		app.Role,
+		app.Boon,
+		app.Profession,
+		app.EliteSpec,
+		app.Note,
+		app.Status,
+		app.SlotId,
+	))
 }
 
 func (db *DB) GetApplication(ctx context.Context, applicationId string) (*pb.GroupApplication, error) {
 	start := time.Now()
 	defer func() { slog.InfoContext(ctx, "db.GetApplication", slog.Duration("elapsed", time.Since(start))) }()
-	query := `SELECT id, account_name, group_id, created_at_sec, updated_at_sec FROM applications WHERE id = ?`
-	var application pb.GroupApplication
-	err := db.db.QueryRowContext(ctx, query, applicationId).Scan(
-		&application.Id,
-		&application.AccountName,
-		&application.GroupId,
-		&application.CreatedAtSec,
-		&application.UpdatedAtSec,
-	)
+	query := `SELECT ` + applicationColumns + ` FROM applications WHERE id = ?`
+	application, err := scanApplication(db.db.QueryRowContext(ctx, query, applicationId))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
-	return &application, err
+	return application, err
 }
 
 func (db *DB) DeleteApplication(ctx context.Context, applicationId string) error {
@@ -257,38 +743,24 @@ func (db *DB) DeleteApplication(ctx context.Context, applicationId string) error
 	return err
 }
 
-func (db *DB) DeleteApplicationsUpdatedBefore(ctx context.Context, t time.Time) ([]*pb.GroupApplication, error) {
+// DeleteApplicationsExpiredBefore deletes applications that were not
+// updated after t, unless their group starts after t.
+func (db *DB) DeleteApplicationsExpiredBefore(ctx context.Context, t time.Time) ([]*pb.GroupApplication, error) {
 	start := time.Now()
 	defer func() {
-		slog.InfoContext(ctx, "db.DeleteApplicationsUpdatedBefore", slog.Duration("elapsed", time.Since(start)))
+		slog.InfoContext(ctx, "db.DeleteApplicationsExpiredBefore", slog.Duration("elapsed", time.Since(start)))
 	}()
 	query := `
         DELETE FROM applications 
-        WHERE updated_at_sec < ? 
-        RETURNING id, group_id, account_name, created_at_sec, updated_at_sec
-    `
+        WHERE MAX(updated_at_sec, COALESCE((SELECT start_at_sec FROM groups WHERE groups.id = applications.group_id), 0)) < ? 
+        RETURNING ` + applicationColumns
 	rows, err := db.db.QueryContext(ctx, query, t.Unix())
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var deletedApps []*pb.GroupApplication
-	for rows.Next() {
-		var app pb.GroupApplication
-		if err := rows.Scan(
-			&app.Id,
-			&app.GroupId,
-			&app.AccountName,
-			&app.CreatedAtSec,
-			&app.UpdatedAtSec,
-		); err != nil {
-			return nil, err
-		}
-		deletedApps = append(deletedApps, &app)
-	}
-
-	return deletedApps, rows.Err()
+	return scanApplications(rows)
 }
 
 func (db *DB) ListApplicationsForGroup(ctx context.Context, groupID string) ([]*pb.GroupApplication, error) {
@@ -296,27 +768,15 @@ func (db *DB) ListApplicationsForGroup(ctx context.Context, groupID string) ([]*
 	defer func() {
 		slog.InfoContext(ctx, "db.ListApplicationsForGroup", slog.Duration("elapsed", time.Since(start)))
 	}()
-	query := `SELECT id, group_id, account_name, created_at_sec, updated_at_sec FROM applications WHERE group_id = ?`
+	// Oldest first, which is the order in which commanders should get to them
+	query := `SELECT ` + applicationColumns + ` FROM applications WHERE group_id = ? ORDER BY created_at_sec, id`
 	rows, err := db.db.QueryContext(ctx, query, groupID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var apps []*pb.GroupApplication
-	for rows.Next() {
-		var app pb.GroupApplication
-		if err := rows.Scan(
-			&app.Id,
-			&app.GroupId,
-			&app.AccountName,
-			&app.CreatedAtSec,
-			&app.UpdatedAtSec); err != nil {
-			return nil, err
-		}
-		apps = append(apps, &app)
-	}
-	return apps, rows.Err()
+	return scanApplications(rows)
 }
 
 func (db *DB) ListApplicationsForAccount(ctx context.Context, accountName string) ([]*pb.GroupApplication, error) {
@@ -324,27 +784,14 @@ func (db *DB) ListApplicationsForAccount(ctx context.Context, accountName string
 	defer func() {
 		slog.InfoContext(ctx, "db.ListApplicationsForGroup", slog.Duration("elapsed", time.Since(start)))
 	}()
-	query := `SELECT id, group_id, account_name, created_at_sec, updated_at_sec FROM applications WHERE account_name = ?`
+	query := `SELECT ` + applicationColumns + ` FROM applications WHERE account_name = ?`
 	rows, err := db.db.QueryContext(ctx, query, accountName)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var apps []*pb.GroupApplication
-	for rows.Next() {
-		var app pb.GroupApplication
-		if err := rows.Scan(
-			&app.Id,
-			&app.GroupId,
-			&app.AccountName,
-			&app.CreatedAtSec,
-			&app.UpdatedAtSec); err != nil {
-			return nil, err
-		}
-		apps = append(apps, &app)
-	}
-	return apps, rows.Err()
+	return scanApplications(rows)
 }
 
 type TouchResult struct {
@@ -372,29 +819,13 @@ func (db *DB) TouchGroupsAndApplications(ctx context.Context, accountName string
         UPDATE groups 
         SET updated_at_sec = ? 
         WHERE creator_id = ?
-        RETURNING id, creator_id, title, kill_proof_id, kill_proof_minimum, created_at_sec, updated_at_sec
-    `, updateTime, accountName)
+        RETURNING `+groupColumns, updateTime, accountName)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	for rows.Next() {
-		var group pb.Group
-		if err := rows.Scan(
-			&group.Id,
-			&group.CreatorId,
-			&group.Title,
-			&group.KillProofId,
-			&group.KillProofMinimum,
-			&group.CreatedAtSec,
-			&group.UpdatedAtSec,
-		); err != nil {
-			return nil, err
-		}
-		result.Groups = append(result.Groups, &group)
-	}
-	if err = rows.Err(); err != nil {
+	if result.Groups, err = scanGroups(rows); err != nil {
 		return nil, err
 	}
 	rows.Close()
@@ -404,27 +835,13 @@ func (db *DB) TouchGroupsAndApplications(ctx context.Context, accountName string
         UPDATE applications 
         SET updated_at_sec = ? 
         WHERE account_name = ?
-        RETURNING id, group_id, account_name, created_at_sec, updated_at_sec
-    `, updateTime, accountName)
+        RETURNING `+applicationColumns, updateTime, accountName)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	for rows.Next() {
-		var app pb.GroupApplication
-		if err := rows.Scan(
-			&app.Id,
-			&app.GroupId,
-			&app.AccountName,
-			&app.CreatedAtSec,
-			&app.UpdatedAtSec,
-		); err != nil {
-			return nil, err
-		}
-		result.Applications = append(result.Applications, &app)
-	}
-	if err = rows.Err(); err != nil {
+	if result.Applications, err = scanApplications(rows); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+// InvitationOperations contains all invitation-related database operations
+const invitationColumns = `id, group_id, commander, account_name, created_at_sec, expires_at_sec`
+
+func scanInvitation(s scanner) (*pb.Invitation, error) {
+	var invitation pb.Invitation
+	if err := s.Scan(
+		&invitation.Id,
+		&invitation.GroupId,
+		&invitation.Commander,
+		&invitation.AccountName,
+		&invitation.CreatedAtSec,
+		&invitation.ExpiresAtSec,
+	); err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+func scanInvitations(rows *sql.Rows) ([]*pb.Invitation, error) {
+	var invitations []*pb.Invitation
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, rows.Err()
+}
+
+// SaveInvitation stores a new invitation, replacing an expired one. It
+// returns nil if the account is already invited to the group.
+func (db *DB) SaveInvitation(ctx context.Context, invitation *pb.Invitation) (*pb.Invitation, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveInvitation", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		INSERT INTO invitations (` + invitationColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(group_id, account_name) DO UPDATE SET
+			id = excluded.id,
+			commander = excluded.commander,
+			created_at_sec = excluded.created_at_sec,
+			expires_at_sec = excluded.expires_at_sec
+		WHERE invitations.expires_at_sec <= excluded.created_at_sec
+		RETURNING ` + invitationColumns
+	saved, err := scanInvitation(db.db.QueryRowContext(ctx, query,
+		invitation.Id,
+		invitation.GroupId,
+		invitation.Commander,
+		invitation.AccountName,
+		invitation.CreatedAtSec,
+		invitation.ExpiresAtSec,
+	))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return saved, err
+}
+
+// GetInvitation returns an invitation that has not expired at t, or nil.
+func (db *DB) GetInvitation(ctx context.Context, id string, t time.Time) (*pb.Invitation, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetInvitation", slog.Duration("elapsed", time.Since(start))) }()
+	query := `SELECT ` + invitationColumns + ` FROM invitations WHERE id = ? AND expires_at_sec > ?`
+	invitation, err := scanInvitation(db.db.QueryRowContext(ctx, query, id, t.Unix()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return invitation, err
+}
+
+func (db *DB) DeleteInvitation(ctx context.Context, id string) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.DeleteInvitation", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `DELETE FROM invitations WHERE id = ?`, id)
+	return err
+}
+
+// DeleteInvitationsExpiredBefore deletes invitations that expired before t.
+func (db *DB) DeleteInvitationsExpiredBefore(ctx context.Context, t time.Time) ([]*pb.Invitation, error) {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.DeleteInvitationsExpiredBefore", slog.Duration("elapsed", time.Since(start)))
+	}()
+	query := `DELETE FROM invitations WHERE expires_at_sec <= ? RETURNING ` + invitationColumns
+	rows, err := db.db.QueryContext(ctx, query, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanInvitations(rows)
+}
+
+// ListInvitationsForGroup returns the invitations to a group that have not
+// expired at t.
+func (db *DB) ListInvitationsForGroup(ctx context.Context, groupID string, t time.Time) ([]*pb.Invitation, error) {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.ListInvitationsForGroup", slog.Duration("elapsed", time.Since(start)))
+	}()
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM invitations
+		WHERE group_id = ? AND expires_at_sec > ?
+		ORDER BY created_at_sec`
+	rows, err := db.db.QueryContext(ctx, query, groupID, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanInvitations(rows)
+}
+
+// ListInvitationsForAccount returns the invitations of an account to
+// existing groups that have not expired at t.
+func (db *DB) ListInvitationsForAccount(ctx context.Context, accountName string, t time.Time) ([]*pb.Invitation, error) {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.ListInvitationsForAccount", slog.Duration("elapsed", time.Since(start)))
+	}()
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM invitations
+		WHERE account_name = ? AND expires_at_sec > ? AND group_id IN (SELECT id FROM groups)
+		ORDER BY created_at_sec`
+	rows, err := db.db.QueryContext(ctx, query, accountName, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanInvitations(rows)
+}
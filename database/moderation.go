@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+// BanOperations contains all ban-related database operations
+const banColumns = `account_name, reason, banned_by, created_at_sec, expires_at_sec`
+
+func scanBan(s scanner) (*pb.Ban, error) {
+	var ban pb.Ban
+	var reason sql.NullString
+	if err := s.Scan(
+		&ban.AccountName,
+		&reason,
+		&ban.BannedBy,
+		&ban.CreatedAtSec,
+		&ban.ExpiresAtSec,
+	); err != nil {
+		return nil, err
+	}
+	ban.Reason = reason.String
+	return &ban, nil
+}
+
+// SaveBan bans an account, replacing any earlier ban of the same account.
+func (db *DB) SaveBan(ctx context.Context, ban *pb.Ban) (*pb.Ban, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveBan", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		INSERT INTO bans (` + banColumns + `)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(account_name) DO UPDATE SET
+			reason = excluded.reason,
+			banned_by = excluded.banned_by,
+			created_at_sec = excluded.created_at_sec,
+			expires_at_sec = excluded.expires_at_sec
+		RETURNING ` + banColumns
+	return scanBan(db.db.QueryRowContext(ctx, query,
+		ban.AccountName,
+		ban.Reason,
+		ban.BannedBy,
+		ban.CreatedAtSec,
+		ban.ExpiresAtSec,
+	))
+}
+
+// DeleteBan lifts the ban of an account and reports whether there was one.
+func (db *DB) DeleteBan(ctx context.Context, accountName string) (bool, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.DeleteBan", slog.Duration("elapsed", time.Since(start))) }()
+	result, err := db.db.ExecContext(ctx, `DELETE FROM bans WHERE account_name = ?`, accountName)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetActiveBan returns the ban of an account that is in effect at t, or nil.
+func (db *DB) GetActiveBan(ctx context.Context, accountName string, t time.Time) (*pb.Ban, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetActiveBan", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		SELECT ` + banColumns + `
+		FROM bans
+		WHERE account_name = ? AND (expires_at_sec = 0 OR expires_at_sec > ?)
+	`
+	ban, err := scanBan(db.db.QueryRowContext(ctx, query, accountName, t.Unix()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return ban, err
+}
+
+// ListActiveBans returns all bans in effect at t.
+func (db *DB) ListActiveBans(ctx context.Context, t time.Time) ([]*pb.Ban, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ListActiveBans", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		SELECT ` + banColumns + `
+		FROM bans
+		WHERE expires_at_sec = 0 OR expires_at_sec > ?
+		ORDER BY created_at_sec DESC
+	`
+	rows, err := db.db.QueryContext(ctx, query, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bans []*pb.Ban
+	for rows.Next() {
+		ban, err := scanBan(rows)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, ban)
+	}
+	return bans, rows.Err()
+}
+
+// AuditEntry records a single admin action.
+type AuditEntry struct {
+	Admin     string
+	Action    string
+	Target    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+func (db *DB) WriteAuditLog(ctx context.Context, entry AuditEntry) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.WriteAuditLog", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `
+		INSERT INTO audit_log (admin, action, target, reason, created_at_sec)
+		VALUES (?, ?, ?, ?, ?)`,
+		entry.Admin,
+		entry.Action,
+		entry.Target,
+		entry.Reason,
+		entry.CreatedAt.Unix(),
+	)
+	return err
+}
+
+// BlockAccount adds an account to a commander's block list.
+func (db *DB) BlockAccount(ctx context.Context, commander, accountName string, t time.Time) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.BlockAccount", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `
+		INSERT INTO blocks (commander, account_name, created_at_sec)
+		VALUES (?, ?, ?)
+		ON CONFLICT(commander, account_name) DO NOTHING`,
+		commander, accountName, t.Unix())
+	return err
+}
+
+// UnblockAccount removes an account from a commander's block list and
+// reports whether it was on it.
+func (db *DB) UnblockAccount(ctx context.Context, commander, accountName string) (bool, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.UnblockAccount", slog.Duration("elapsed", time.Since(start))) }()
+	result, err := db.db.ExecContext(ctx, `DELETE FROM blocks WHERE commander = ? AND account_name = ?`, commander, accountName)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+func (db *DB) IsBlocked(ctx context.Context, commander, accountName string) (bool, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.IsBlocked", slog.Duration("elapsed", time.Since(start))) }()
+	var blocked bool
+	err := db.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM blocks WHERE commander = ? AND account_name = ?)`,
+		commander, accountName).Scan(&blocked)
+	return blocked, err
+}
+
+func (db *DB) ListBlocked(ctx context.Context, commander string) ([]*pb.BlockedAccount, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ListBlocked", slog.Duration("elapsed", time.Since(start))) }()
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT account_name, created_at_sec
+		FROM blocks
+		WHERE commander = ?
+		ORDER BY created_at_sec DESC`, commander)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocked []*pb.BlockedAccount
+	for rows.Next() {
+		var b pb.BlockedAccount
+		if err := rows.Scan(&b.AccountName, &b.CreatedAtSec); err != nil {
+			return nil, err
+		}
+		blocked = append(blocked, &b)
+	}
+	return blocked, rows.Err()
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+// ReportOperations contains all report-related database operations
+const reportColumns = `id, reporter, target_type, target_id, target_account_name, reason, snapshot,
+	created_at_sec, resolved, resolved_by, resolution, resolved_at_sec`
+
+func scanReport(s scanner) (*pb.Report, error) {
+	var report pb.Report
+	var reason, snapshot, resolvedBy, resolution sql.NullString
+	if err := s.Scan(
+		&report.Id,
+		&report.Reporter,
+		&report.TargetType,
+		&report.TargetId,
+		&report.TargetAccountName,
+		&reason,
+		&snapshot,
+		&report.CreatedAtSec,
+		&report.Resolved,
+		&resolvedBy,
+		&resolution,
+		&report.ResolvedAtSec,
+	); err != nil {
+		return nil, err
+	}
+	report.Reason = reason.String
+	report.Snapshot = snapshot.String
+	report.ResolvedBy = resolvedBy.String
+	report.Resolution = resolution.String
+	return &report, nil
+}
+
+func scanReports(rows *sql.Rows) ([]*pb.Report, error) {
+	var reports []*pb.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
+
+// SaveReport stores a new report. It returns nil if the reporter already
+// has an open report for the same target.
+func (db *DB) SaveReport(ctx context.Context, report *pb.Report) (*pb.Report, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveReport", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		INSERT INTO reports (id, reporter, target_type, target_id, target_account_name, reason, snapshot, created_at_sec)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT DO NOTHING
+		RETURNING ` + reportColumns
+	saved, err := scanReport(db.db.QueryRowContext(ctx, query,
+		report.Id,
+		report.Reporter,
+		report.TargetType,
+		report.TargetId,
+		report.TargetAccountName,
+		report.Reason,
+		report.Snapshot,
+		report.CreatedAtSec,
+	))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return saved, err
+}
+
+func (db *DB) GetReport(ctx context.Context, id string) (*pb.Report, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetReport", slog.Duration("elapsed", time.Since(start))) }()
+	report, err := scanReport(db.db.QueryRowContext(ctx, `SELECT `+reportColumns+` FROM reports WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return report, err
+}
+
+// CountOpenReporters returns the number of distinct accounts with an open
+// report for a target, not counting excludeReporter.
+func (db *DB) CountOpenReporters(ctx context.Context, targetType pb.ReportTargetType, targetID, excludeReporter string) (int, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.CountOpenReporters", slog.Duration("elapsed", time.Since(start))) }()
+	var count int
+	err := db.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT reporter)
+		FROM reports
+		WHERE target_type = ? AND target_id = ? AND resolved = 0 AND reporter != ?`,
+		targetType, targetID, excludeReporter).Scan(&count)
+	return count, err
+}
+
+func (db *DB) ListReports(ctx context.Context, includeResolved bool) ([]*pb.Report, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ListReports", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		SELECT ` + reportColumns + `
+		FROM reports
+		WHERE resolved = 0 OR ?
+		ORDER BY created_at_sec DESC
+	`
+	rows, err := db.db.QueryContext(ctx, query, includeResolved)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanReports(rows)
+}
+
+// ResolveReports resolves all open reports for a target.
+func (db *DB) ResolveReports(ctx context.Context, targetType pb.ReportTargetType, targetID, resolvedBy, resolution string, t time.Time) ([]*pb.Report, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ResolveReports", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		UPDATE reports
+		SET resolved = 1, resolved_by = ?, resolution = ?, resolved_at_sec = ?
+		WHERE target_type = ? AND target_id = ? AND resolved = 0
+		RETURNING ` + reportColumns
+	rows, err := db.db.QueryContext(ctx, query, resolvedBy, resolution, t.Unix(), targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanReports(rows)
+}
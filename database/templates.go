@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+const templateColumns = `id, owner_id, name, group_settings, recurrence, lead_time_sec,
+	created_at_sec, updated_at_sec, last_start_at_sec`
+
+func scanTemplate(s scanner) (*pb.GroupTemplate, error) {
+	var template pb.GroupTemplate
+	var settings, recurrence sql.NullString
+	if err := s.Scan(
+		&template.Id,
+		&template.OwnerId,
+		&template.Name,
+		&settings,
+		&recurrence,
+		&template.LeadTimeSec,
+		&template.CreatedAtSec,
+		&template.UpdatedAtSec,
+		&template.LastStartAtSec,
+	); err != nil {
+		return nil, err
+	}
+	var group pb.CreateGroupRequest
+	if ok, err := unmarshalMessage(settings, &group); err != nil {
+		return nil, err
+	} else if ok {
+		template.Group = &group
+	}
+	var rec pb.Recurrence
+	if ok, err := unmarshalMessage(recurrence, &rec); err != nil {
+		return nil, err
+	} else if ok {
+		template.Recurrence = &rec
+	}
+	return &template, nil
+}
+
+func scanTemplates(rows *sql.Rows) ([]*pb.GroupTemplate, error) {
+	var templates []*pb.GroupTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+func (db *DB) SaveTemplate(ctx context.Context, template *pb.GroupTemplate) (*pb.GroupTemplate, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SaveTemplate", slog.Duration("elapsed", time.Since(start))) }()
+	query := `
+		INSERT INTO group_templates (` + templateColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name = excluded.name,
+			group_settings = excluded.group_settings,
+			recurrence = excluded.recurrence,
+			lead_time_sec = excluded.lead_time_sec,
+			updated_at_sec = excluded.updated_at_sec,
+			last_start_at_sec = excluded.last_start_at_sec
+		RETURNING ` + templateColumns
+	settings, err := marshalMessage(template.Group)
+	if err != nil {
+		return nil, err
+	}
+	recurrence, err := marshalMessage(template.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	return scanTemplate(db.db.QueryRowContext(ctx, query,
+		template.Id,
+		template.OwnerId,
+		template.Name,
+		settings,
+		recurrence,
+		template.LeadTimeSec,
+		template.CreatedAtSec,
+		template.UpdatedAtSec,
+		template.LastStartAtSec,
+	))
+}
+
+func (db *DB) GetTemplate(ctx context.Context, id string) (*pb.GroupTemplate, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.GetTemplate", slog.Duration("elapsed", time.Since(start))) }()
+	template, err := scanTemplate(db.db.QueryRowContext(ctx, `SELECT `+templateColumns+` FROM group_templates WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return template, err
+}
+
+func (db *DB) DeleteTemplate(ctx context.Context, id string) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.DeleteTemplate", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `DELETE FROM group_templates WHERE id = ?`, id)
+	return err
+}
+
+func (db *DB) ListTemplatesForAccount(ctx context.Context, accountName string) ([]*pb.GroupTemplate, error) {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.ListTemplatesForAccount", slog.Duration("elapsed", time.Since(start)))
+	}()
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+templateColumns+`
+		FROM group_templates
+		WHERE owner_id = ?
+		ORDER BY created_at_sec`, accountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTemplates(rows)
+}
+
+// ListRecurringTemplates returns all templates with a recurrence.
+func (db *DB) ListRecurringTemplates(ctx context.Context) ([]*pb.GroupTemplate, error) {
+	start := time.Now()
+	defer func() {
+		slog.InfoContext(ctx, "db.ListRecurringTemplates", slog.Duration("elapsed", time.Since(start)))
+	}()
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+templateColumns+`
+		FROM group_templates
+		WHERE recurrence IS NOT NULL`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTemplates(rows)
+}
+
+// SetTemplateLastStart records the start of the latest group created from
+// a template.
+func (db *DB) SetTemplateLastStart(ctx context.Context, id string, t time.Time) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.SetTemplateLastStart", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `UPDATE group_templates SET last_start_at_sec = ? WHERE id = ?`, t.Unix(), id)
+	return err
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"time"
+)
+
+// WhitelistAccount adds an account to a commander's whitelist.
+func (db *DB) WhitelistAccount(ctx context.Context, commander, accountName string, t time.Time) error {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.WhitelistAccount", slog.Duration("elapsed", time.Since(start))) }()
+	_, err := db.db.ExecContext(ctx, `
+		INSERT INTO whitelist (commander, account_name, created_at_sec)
+		VALUES (?, ?, ?)
+		ON CONFLICT(commander, account_name) DO NOTHING`,
+		commander, accountName, t.Unix())
+	return err
+}
+
+// UnwhitelistAccount removes an account from a commander's whitelist and
+// reports whether it was on it.
+func (db *DB) UnwhitelistAccount(ctx context.Context, commander, accountName string) (bool, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.UnwhitelistAccount", slog.Duration("elapsed", time.Since(start))) }()
+	result, err := db.db.ExecContext(ctx, `DELETE FROM whitelist WHERE commander = ? AND account_name = ?`, commander, accountName)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+func (db *DB) IsWhitelisted(ctx context.Context, commander, accountName string) (bool, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.IsWhitelisted", slog.Duration("elapsed", time.Since(start))) }()
+	var whitelisted bool
+	err := db.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM whitelist WHERE commander = ? AND account_name = ?)`,
+		commander, accountName).Scan(&whitelisted)
+	return whitelisted, err
+}
+
+func (db *DB) ListWhitelisted(ctx context.Context, commander string) ([]*pb.WhitelistedAccount, error) {
+	start := time.Now()
+	defer func() { slog.InfoContext(ctx, "db.ListWhitelisted", slog.Duration("elapsed", time.Since(start))) }()
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT account_name, created_at_sec
+		FROM whitelist
+		WHERE commander = ?
+		ORDER BY created_at_sec DESC`, commander)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var whitelisted []*pb.WhitelistedAccount
+	for rows.Next() {
+		var w pb.WhitelistedAccount
+		if err := rows.Scan(&w.AccountName, &w.CreatedAtSec); err != nil {
+			return nil, err
+		}
+		whitelisted = append(whitelisted, &w)
+	}
+	return whitelisted, rows.Err()
+}
@@ -0,0 +1,109 @@
+// Package ical writes iCalendar (RFC 5545) feeds.
+package ical
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Status is the STATUS property of an event.
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Event is a VEVENT. All times are written in UTC, which calendar clients
+// show in their own time zone, so no VTIMEZONE is needed.
+type Event struct {
+	// UID must stay the same across updates of the event.
+	UID          string
+	Sequence     int64
+	Status       Status
+	Summary      string
+	Description  string
+	Start, End   time.Time
+	LastModified time.Time
+}
+
+// Calendar is a VCALENDAR of events.
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+const timeFormat = "20060102T150405Z"
+
+// WriteTo writes c with CRLF line endings and long lines folded.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	line := func(name, value string) {
+		fold(&b, name+":"+value)
+	}
+	now := time.Now().UTC().Format(timeFormat)
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", escape(c.ProdID))
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escape(c.Name))
+	}
+	for _, e := range c.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", escape(e.UID))
+		line("DTSTAMP", now)
+		line("DTSTART", e.Start.UTC().Format(timeFormat))
+		line("DTEND", e.End.UTC().Format(timeFormat))
+		line("SEQUENCE", strconv.FormatInt(e.Sequence, 10))
+		if !e.LastModified.IsZero() {
+			line("LAST-MODIFIED", e.LastModified.UTC().Format(timeFormat))
+		}
+		if e.Status != "" {
+			line("STATUS", string(e.Status))
+		}
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return b.WriteTo(w)
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// fold writes a content line, splitting it into lines of at most 75 octets
+// without splitting UTF-8 sequences.
+func fold(b *bytes.Buffer, s string) {
+	// Continuation lines start with a space that counts towards their length
+	for limit := 75; len(s) > limit; limit = 74 {
+		i := limit
+		for !utf8.RuneStart(s[i]) {
+			i--
+		}
+		b.WriteString(s[:i])
+		b.WriteString("\r\n ")
+		s = s[i:]
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
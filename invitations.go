@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	invitationTTL          = 24 * time.Hour
+	maxInvitationsPerGroup = 50
+)
+
+func newInvitationUpdate(invitation *pb.Invitation) *pb.GroupApplicationUpdate {
+	return &pb.GroupApplicationUpdate{Update: &pb.GroupApplicationUpdate_NewInvitation{NewInvitation: invitation}}
+}
+
+func removedInvitationUpdate(invitation *pb.Invitation) *pb.GroupApplicationUpdate {
+	return &pb.GroupApplicationUpdate{Update: &pb.GroupApplicationUpdate_RemovedInvitationId{RemovedInvitationId: invitation.Id}}
+}
+
+func (s *Server) InviteToGroup(ctx context.Context, req *pb.InviteToGroupRequest) (*pb.InviteToGroupResponse, error) {
+	client := mustGetClient(ctx)
+	if req.AccountName == "" {
+		return nil, status.Error(codes.InvalidArgument, "Account name is required")
+	}
+	if req.AccountName == client.AccountName {
+		return nil, status.Error(codes.InvalidArgument, "Cannot invite yourself")
+	}
+
+	group, err := s.validateGroupOwnership(ctx, req.GroupId, client.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if group.State == pb.GroupState_GROUP_STATE_CLOSED {
+		return nil, status.Error(codes.FailedPrecondition, "Group is closed")
+	}
+
+	applications, err := s.db.ListApplicationsForGroup(ctx, group.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListApplicationsForGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to check existing applications")
+	}
+	for _, application := range applications {
+		if application.AccountName == req.AccountName {
+			return nil, status.Error(codes.AlreadyExists, "Account already applied to this group")
+		}
+	}
+
+	now := time.Now()
+	invitations, err := s.db.ListInvitationsForGroup(ctx, group.Id, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListInvitationsForGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to check existing invitations")
+	}
+	if len(invitations) >= maxInvitationsPerGroup {
+		return nil, status.Errorf(codes.ResourceExhausted, "At most %d open invitations per group", maxInvitationsPerGroup)
+	}
+
+	invitation, err := s.db.SaveInvitation(ctx, &pb.Invitation{
+		Id:           uuid.New().String(),
+		GroupId:      group.Id,
+		Commander:    client.AccountName,
+		AccountName:  req.AccountName,
+		CreatedAtSec: now.Unix(),
+		ExpiresAtSec: now.Add(invitationTTL).Unix(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveInvitation", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create invitation")
+	}
+	if invitation == nil {
+		return nil, status.Error(codes.AlreadyExists, "Account already invited")
+	}
+
+	s.broadcastApplicationUpdate(group.Id, invitation.AccountName, newInvitationUpdate(invitation))
+
+	return &pb.InviteToGroupResponse{Invitation: invitation}, nil
+}
+
+// RespondToInvitation accepts or declines an invitation. Accepting is like
+// applying, except that the group need not be visible to the invitee and
+// the application is accepted right away.
+func (s *Server) RespondToInvitation(ctx context.Context, req *pb.RespondToInvitationRequest) (*pb.RespondToInvitationResponse, error) {
+	client := mustGetClient(ctx)
+
+	now := time.Now()
+	invitation, err := s.db.GetInvitation(ctx, req.InvitationId, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetInvitation", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get invitation")
+	}
+	if invitation == nil || invitation.AccountName != client.AccountName {
+		return nil, status.Error(codes.NotFound, "Invitation not found")
+	}
+
+	if !req.Accept {
+		if err := s.deleteInvitation(ctx, invitation); err != nil {
+			return nil, err
+		}
+		return &pb.RespondToInvitationResponse{}, nil
+	}
+
+	application := &pb.GroupApplication{
+		Id:               uuid.New().String(),
+		AccountName:      client.AccountName,
+		GroupId:          invitation.GroupId,
+		CreatedAtSec:     now.Unix(),
+		UpdatedAtSec:     now.Unix(),
+		ApplicantProfile: accountProfileToProto(client.Profile),
+		Role:             req.Role,
+		Boon:             req.Boon,
+		Profession:       req.Profession,
+		EliteSpec:        req.EliteSpec,
+		Note:             strings.TrimSpace(req.Note),
+	}
+	if err := validateLoadout(application); err != nil {
+		return nil, err
+	}
+	// Fetched before locking, kill proof comes from an external API
+	s.attachKillProof(ctx, application)
+
+	unlock := s.slotLocks.lock(invitation.GroupId)
+	defer unlock()
+	group, err := s.db.GetGroup(ctx, invitation.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get group")
+	}
+	if group == nil {
+		return nil, status.Error(codes.NotFound, "Group not found")
+	}
+	if err := s.checkApplication(ctx, group, application, client); err != nil {
+		return nil, err
+	}
+	flagged, err := s.checkNote(application.Note)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := acceptSlot(group, application, "")
+	if err != nil {
+		return nil, err
+	}
+	if slot != nil {
+		application.SlotId = slot.Id
+	}
+	application.Status = pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED
+
+	savedApp, err := s.db.SaveApplication(ctx, application, group.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create application")
+	}
+	s.flagApplication(ctx, savedApp, flagged)
+	if err := s.deleteInvitation(ctx, invitation); err != nil {
+		// The application already stands, the invitation expires on its own
+		slog.ErrorContext(ctx, "s.deleteInvitation", "err", err)
+	}
+	s.refreshSlots(ctx, group.Id)
+	savedApp.KillProof = application.KillProof
+
+	s.broadcastApplicationUpdate(group.Id, savedApp.AccountName, &pb.GroupApplicationUpdate{
+		Update: &pb.GroupApplicationUpdate_NewApplication{NewApplication: savedApp},
+	})
+
+	return &pb.RespondToInvitationResponse{Application: savedApp}, nil
+}
+
+func (s *Server) CancelInvitation(ctx context.Context, req *pb.CancelInvitationRequest) (*pb.CancelInvitationResponse, error) {
+	client := mustGetClient(ctx)
+
+	invitation, err := s.db.GetInvitation(ctx, req.InvitationId, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetInvitation", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get invitation")
+	}
+	if invitation == nil || invitation.Commander != client.AccountName {
+		return nil, status.Error(codes.NotFound, "Invitation not found")
+	}
+	if err := s.deleteInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+	return &pb.CancelInvitationResponse{}, nil
+}
+
+func (s *Server) ListInvitations(ctx context.Context, req *pb.ListInvitationsRequest) (*pb.ListInvitationsResponse, error) {
+	client := mustGetClient(ctx)
+
+	var invitations []*pb.Invitation
+	var err error
+	if req.GroupId != "" {
+		if _, err := s.validateGroupOwnership(ctx, req.GroupId, client.AccountName); err != nil {
+			return nil, err
+		}
+		invitations, err = s.db.ListInvitationsForGroup(ctx, req.GroupId, time.Now())
+	} else {
+		invitations, err = s.db.ListInvitationsForAccount(ctx, client.AccountName, time.Now())
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListInvitations", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list invitations")
+	}
+	return &pb.ListInvitationsResponse{Invitations: invitations}, nil
+}
+
+func (s *Server) deleteInvitation(ctx context.Context, invitation *pb.Invitation) error {
+	if err := s.db.DeleteInvitation(ctx, invitation.Id); err != nil {
+		slog.ErrorContext(ctx, "s.db.DeleteInvitation", "err", err)
+		return status.Error(codes.Internal, "Failed to delete invitation")
+	}
+	s.broadcastApplicationUpdate(invitation.GroupId, invitation.AccountName, removedInvitationUpdate(invitation))
+	return nil
+}
@@ -5,23 +5,119 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"gw2lfgserver/clientinfo"
+	"gw2lfgserver/tokenhash"
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// API key permissions as reported by the GW2 tokeninfo endpoint.
+const (
+	PermissionAccount     = "account"
+	PermissionBuilds      = "builds"
+	PermissionCharacters  = "characters"
+	PermissionGuilds      = "guilds"
+	PermissionInventories = "inventories"
+	PermissionProgression = "progression"
+	PermissionPvp         = "pvp"
+	PermissionTradingpost = "tradingpost"
+	PermissionUnlocks     = "unlocks"
+	PermissionWallet      = "wallet"
+	PermissionWvw         = "wvw"
+)
+
+// KeyInfo is what the resolver knows about a validated API key.
+type KeyInfo struct {
+	AccountName string
+	Profile     *clientinfo.Profile
+	Permissions []string
+	Subtoken    bool
+	// ExpiresAt is only set for subtokens that were issued with an expiry.
+	ExpiresAt time.Time
+}
+
+const (
+	// Successfully resolved keys are trusted for this long.
+	keyTTL = time.Hour
+	// Keys rejected by the GW2 API are remembered for a shorter time, so a
+	// fixed key or a freshly granted permission is picked up quickly.
+	invalidKeyTTL = 5 * time.Minute
+
+	maxAttempts    = 3
+	initialBackoff = 200 * time.Millisecond
+
+	breakerThreshold = 5
+	breakerCooldown  = 30 * time.Second
+
+	// Keys used within this window before expiry are refreshed in the
+	// background, so active users never wait for the GW2 API.
+	refreshBefore = 5 * time.Minute
+	fetchTimeout  = 15 * time.Second
+)
+
+var (
+	// errInvalidKey is returned when the GW2 API rejects the key itself.
+	errInvalidKey = errors.New("invalid API key")
+	// errUnavailable is returned for rate limiting, server and network
+	// errors, none of which say anything about the key.
+	errUnavailable = errors.New("GW2 API unavailable")
+	// errFetchTimeout is the cause of fetches running into fetchTimeout,
+	// which unlike a cancelled caller means the upstream is unhealthy.
+	errFetchTimeout = errors.New("GW2 API fetch timed out")
+)
+
+// Config holds configuration for the resolver
+type Config struct {
+	// CacheSize bounds the number of cached keys. The least recently used
+	// keys are evicted first.
+	CacheSize int
+}
+
+// Stats describes the cache behaviour since startup.
+type Stats struct {
+	Size      int
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Refreshes int64
+}
+
 type cacheEntry struct {
-	name       string
+	info       *KeyInfo
 	err        error
-	createTime time.Time
+	expireTime time.Time
+	refreshing bool
 }
 
 type Resolver struct {
-	cache map[string]cacheEntry
-	mu    sync.RWMutex
+	// cache is keyed by the hashed API key.
+	cache   *lru
+	mu      sync.Mutex
+	flight  flightGroup
+	breaker *breaker
+	hasher  *tokenhash.Hasher
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+	refreshes atomic.Int64
+}
+
+type tokenInfo struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+	Type        string   `json:"type"`                 // "APIKey" or "Subtoken"
+	ExpiresAt   string   `json:"expires_at,omitempty"` // Only present for subtokens
+	IssuedAt    string   `json:"issued_at,omitempty"`  // Only present for subtokens
 }
 
 type account struct {
@@ -46,74 +142,277 @@ type account struct {
 	BuildStorageSlots *int    `json:"build_storage_slots,omitempty"` // Requires additional builds scope
 }
 
-func New() *Resolver {
+func (a *account) profile() *clientinfo.Profile {
+	p := &clientinfo.Profile{
+		World:     a.World,
+		Guilds:    a.Guilds,
+		Access:    a.Access,
+		Commander: a.Commander,
+		Age:       time.Duration(a.Age) * time.Second,
+	}
+	if created, err := time.Parse(time.RFC3339, a.Created); err == nil {
+		p.Created = created
+	}
+	if a.GuildLeader != nil {
+		p.GuildLeader = *a.GuildLeader
+	}
+	if a.FractalLevel != nil {
+		p.FractalLevel = *a.FractalLevel
+	}
+	if a.Wvw != nil {
+		p.WvwTeamID = a.Wvw.TeamID
+	}
+	return p
+}
+
+func New(cfg Config, hasher *tokenhash.Hasher) *Resolver {
 	return &Resolver{
-		cache: make(map[string]cacheEntry),
+		cache:   newLRU(cfg.CacheSize),
+		breaker: newBreaker(breakerThreshold, breakerCooldown),
+		hasher:  hasher,
 	}
 }
 
-func (r *Resolver) Resolve(ctx context.Context, key string) (string, error) {
-	if name, err := r.getCached(key); err != nil {
-		return "", status.Error(codes.Internal, fmt.Errorf("failed to get cached key: %w", err).Error())
-	} else if name != nil {
-		return *name, nil
+func (r *Resolver) Resolve(ctx context.Context, key string) (*KeyInfo, error) {
+	keyHash := r.hasher.Hash(key)
+	if info, refresh, err := r.getCached(keyHash); err != nil {
+		r.hits.Add(1)
+		return nil, err
+	} else if info != nil {
+		r.hits.Add(1)
+		if refresh {
+			go r.refresh(keyHash, key)
+		}
+		return info, nil
 	}
+	r.misses.Add(1)
 
-	accountName, err := r.fetch(ctx, key)
-	if err != nil {
-		if !(errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)) {
-			r.cacheResult(key, "", err)
+	return r.flight.do(keyHash, func() (*KeyInfo, error) {
+		// Other requests may be waiting for this result, so it must not
+		// depend on the caller staying around.
+		ctx, cancel := context.WithTimeoutCause(context.WithoutCancel(ctx), fetchTimeout, errFetchTimeout)
+		defer cancel()
+		return r.resolve(ctx, keyHash, key)
+	})
+}
+
+// Stats returns the cache counters.
+func (r *Resolver) Stats() Stats {
+	r.mu.Lock()
+	size := r.cache.len()
+	r.mu.Unlock()
+
+	return Stats{
+		Size:      size,
+		Hits:      r.hits.Load(),
+		Misses:    r.misses.Load(),
+		Evictions: r.evictions.Load(),
+		Refreshes: r.refreshes.Load(),
+	}
+}
+
+// resolve fetches the key from the GW2 API and caches the outcome.
+func (r *Resolver) resolve(ctx context.Context, keyHash, key string) (*KeyInfo, error) {
+	info, err := r.fetch(ctx, key)
+	switch {
+	case err == nil:
+		r.cacheResult(keyHash, info, nil, keyTTL)
+		return info, nil
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		return nil, status.FromContextError(err).Err()
+	case errors.Is(err, errUnavailable):
+		// Not cached: the next request should try again.
+		return nil, status.Error(codes.Unavailable, "GW2 API is currently unavailable, please retry later")
+	}
+
+	if _, ok := status.FromError(err); !ok {
+		// Upstream error details stay in the logs, they are of no use to the client.
+		slog.ErrorContext(ctx, "r.fetch", "err", err)
+		err = status.Error(codes.Internal, "failed to fetch account information")
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		r.cacheResult(keyHash, nil, err, invalidKeyTTL)
+	}
+	return nil, err
+}
+
+// refresh re-resolves a key that is about to expire. If the GW2 API is
+// unavailable the current entry is kept until it expires.
+func (r *Resolver) refresh(keyHash, key string) {
+	r.refreshes.Add(1)
+	_, err := r.flight.do(keyHash, func() (*KeyInfo, error) {
+		ctx, cancel := context.WithTimeoutCause(context.Background(), fetchTimeout, errFetchTimeout)
+		defer cancel()
+		return r.resolve(ctx, keyHash, key)
+	})
+	if err != nil && status.Code(err) != codes.Unauthenticated {
+		slog.Warn("failed to refresh API key", "err", err)
+		r.mu.Lock()
+		if entry, ok := r.cache.get(keyHash); ok {
+			entry.refreshing = false
 		}
-		return "", status.Error(codes.Internal, fmt.Errorf("failed to fetch account information: %w", err).Error())
-	} else {
-		r.cacheResult(key, accountName, nil)
-		return accountName, nil
+		r.mu.Unlock()
 	}
 }
 
-func (r *Resolver) getCached(key string) (*string, error) {
+// getCached returns the cached result for the key and whether it should be
+// refreshed in the background.
+func (r *Resolver) getCached(keyHash string) (*KeyInfo, bool, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	entry, ok := r.cache[key]
+	entry, ok := r.cache.get(keyHash)
 	if !ok {
-		return nil, nil
+		return nil, false, nil
 	}
-	if time.Since(entry.createTime) > time.Hour {
-		delete(r.cache, key)
-		return nil, nil
+	if time.Now().After(entry.expireTime) {
+		r.cache.remove(keyHash)
+		return nil, false, nil
 	}
 	if entry.err != nil {
-		return nil, entry.err
+		return nil, false, entry.err
+	}
+	if !entry.info.ExpiresAt.IsZero() && time.Now().After(entry.info.ExpiresAt) {
+		r.cache.remove(keyHash)
+		return nil, false, status.Error(codes.Unauthenticated, "API subtoken has expired")
 	}
-	return &entry.name, nil
+
+	refresh := !entry.refreshing && time.Until(entry.expireTime) < refreshBefore
+	if refresh {
+		entry.refreshing = true
+	}
+	return entry.info, refresh, nil
 }
 
-func (r *Resolver) cacheResult(key, name string, err error) {
+func (r *Resolver) cacheResult(keyHash string, info *KeyInfo, err error, ttl time.Duration) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.cache[key] = cacheEntry{
-		name:       name,
+	evicted := r.cache.add(keyHash, &cacheEntry{
+		info:       info,
 		err:        err,
-		createTime: time.Now(),
+		expireTime: time.Now().Add(ttl),
+	})
+	r.evictions.Add(int64(evicted))
+}
+
+func (r *Resolver) fetch(ctx context.Context, key string) (*KeyInfo, error) {
+	var token tokenInfo
+	if err := r.get(ctx, "/v2/tokeninfo", key, &token); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(token.Permissions, PermissionAccount) {
+		return nil, status.Error(codes.Unauthenticated, "API key is missing the account permission")
+	}
+
+	info := &KeyInfo{
+		Permissions: token.Permissions,
+		Subtoken:    token.Type == "Subtoken",
+	}
+	if token.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse subtoken expiry: %w", err)
+		}
+		if time.Now().After(expiresAt) {
+			return nil, status.Error(codes.Unauthenticated, "API subtoken has expired")
+		}
+		info.ExpiresAt = expiresAt
+	}
+
+	var account account
+	if err := r.get(ctx, "/v2/account", key, &account); err != nil {
+		return nil, err
+	}
+	if account.Name == "" {
+		return nil, status.Error(codes.Unauthenticated, "API key did not resolve to an account")
+	}
+	info.AccountName = account.Name
+	info.Profile = account.profile()
+
+	return info, nil
+}
+
+// get fetches path from the GW2 API, retrying upstream failures with
+// exponential backoff. Rejected keys are reported as Unauthenticated.
+func (r *Resolver) get(ctx context.Context, path, key string, v any) error {
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		if !r.breaker.Allow() {
+			return fmt.Errorf("%w: circuit breaker open", errUnavailable)
+		}
+
+		err := r.getOnce(ctx, path, key, v)
+		switch {
+		case err != nil && ctx.Err() != nil && !errors.Is(context.Cause(ctx), errFetchTimeout):
+			// The caller went away, the upstream may be healthy or not
+			r.breaker.Abandon()
+			return err
+		case err != nil && ctx.Err() != nil:
+			// The upstream hung until fetchTimeout
+			r.breaker.Failure()
+		case !errors.Is(err, errUnavailable):
+			// Invalid keys are a healthy answer from the upstream.
+			r.breaker.Success()
+		default:
+			r.breaker.Failure()
+		}
+		if errors.Is(err, errInvalidKey) {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		if !errors.Is(err, errUnavailable) || attempt == maxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
 }
 
-func (r *Resolver) fetch(ctx context.Context, key string) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.guildwars2.com/v2/account?access_token="+key, nil)
+func (r *Resolver) getOnce(ctx context.Context, path, key string, v any) error {
+	// The key goes into a header rather than the query string, since
+	// http.Client errors include the request URL.
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.guildwars2.com"+path, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
-	accountRes, err := http.DefaultClient.Do(req)
+	req.Header.Set("Authorization", "Bearer "+key)
+	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to send request: %w", err)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: failed to send request: %w", errUnavailable, err)
 	}
-	defer accountRes.Body.Close()
+	defer res.Body.Close()
 
-	var account account
-	if err = json.NewDecoder(accountRes.Body).Decode(&account); err != nil {
-		return "", fmt.Errorf("failed to decode account: %w", err)
+	var apiErr struct {
+		Text string `json:"text"`
+	}
+	switch {
+	case res.StatusCode == http.StatusOK:
+		if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+			return fmt.Errorf("%w: failed to decode response: %w", errUnavailable, err)
+		}
+		return nil
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		_ = json.NewDecoder(res.Body).Decode(&apiErr)
+		if apiErr.Text == "" {
+			apiErr.Text = res.Status
+		}
+		return fmt.Errorf("%w: %s", errInvalidKey, apiErr.Text)
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500:
+		return fmt.Errorf("%w: %s", errUnavailable, res.Status)
+	default:
+		// The GW2 API has historically answered bad keys with a 400 too.
+		_ = json.NewDecoder(res.Body).Decode(&apiErr)
+		if strings.EqualFold(apiErr.Text, "Invalid access token") {
+			return fmt.Errorf("%w: %s", errInvalidKey, apiErr.Text)
+		}
+		return fmt.Errorf("unexpected response from GW2 API: %s", res.Status)
 	}
-	return account.Name, nil
 }
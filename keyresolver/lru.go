@@ -0,0 +1,63 @@
+package keyresolver
+
+import "container/list"
+
+// lru is a size bounded least recently used cache of resolved keys. It is
+// not safe for concurrent use, the Resolver guards it with its mutex.
+type lru struct {
+	capacity int
+	order    *list.List // front is most recently used
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	keyHash string
+	entry   *cacheEntry
+}
+
+func newLRU(capacity int) *lru {
+	return &lru{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (c *lru) get(keyHash string) (*cacheEntry, bool) {
+	el, ok := c.items[keyHash]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// add inserts or replaces an entry and returns the number of evicted entries.
+func (c *lru) add(keyHash string, entry *cacheEntry) int {
+	if el, ok := c.items[keyHash]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(el)
+		return 0
+	}
+	c.items[keyHash] = c.order.PushFront(&lruItem{keyHash: keyHash, entry: entry})
+
+	evicted := 0
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).keyHash)
+		evicted++
+	}
+	return evicted
+}
+
+func (c *lru) remove(keyHash string) {
+	if el, ok := c.items[keyHash]; ok {
+		c.order.Remove(el)
+		delete(c.items, keyHash)
+	}
+}
+
+func (c *lru) len() int {
+	return c.order.Len()
+}
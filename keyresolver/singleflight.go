@@ -0,0 +1,42 @@
+package keyresolver
+
+import "sync"
+
+// flightGroup deduplicates concurrent lookups of the same key, so a burst
+// of requests with a new key results in a single round trip to the GW2 API.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	wg   sync.WaitGroup
+	info *KeyInfo
+	err  error
+}
+
+// do runs fn once for all concurrent callers with the same key.
+func (g *flightGroup) do(key string, fn func() (*KeyInfo, error)) (*KeyInfo, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.info, c.err
+	}
+	c := &flightCall{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	c.info, c.err = fn()
+	c.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return c.info, c.err
+}
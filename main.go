@@ -2,14 +2,19 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
+	// Embedded so template time zones work without system zoneinfo
+	_ "time/tzdata"
 
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -18,11 +23,15 @@ import (
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 
 	"gw2lfgserver/authenticator"
+	"gw2lfgserver/contentpolicy"
 	"gw2lfgserver/database"
 	"gw2lfgserver/keyresolver"
 	"gw2lfgserver/kpme"
 	pb "gw2lfgserver/pb"
 	"gw2lfgserver/ratelimit"
+	"gw2lfgserver/redact"
+	"gw2lfgserver/session"
+	"gw2lfgserver/tokenhash"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -46,6 +55,22 @@ type Config struct {
 	DatabasePath        string
 	DatabaseEntryTTL    time.Duration
 	DatabaseCleanupFreq time.Duration
+	TokenHashSalt       string
+	KeyCacheSize        int
+	SessionKeys         []session.Key
+	SessionTTL          time.Duration
+	SessionRefreshTTL   time.Duration
+	PublicRead          bool
+	ReportHideThreshold int
+	GroupFormingLead    time.Duration
+	GroupCloseAfter     time.Duration
+	TitleMaxLength      int
+	BlocklistPath       string
+	ContentActions      map[contentpolicy.Rule]contentpolicy.Action
+	RateLimits          ratelimit.Config
+	TrustedProxies      []netip.Prefix
+	MaxStreamsPerClient int
+	Admins              []string
 }
 
 func loadConfig() (*Config, error) {
@@ -67,7 +92,7 @@ func loadConfig() (*Config, error) {
 	}
 
 	// Get optional configs with defaults
-	metricsPort := 9090
+	metricsPort := 0
 	if mp := os.Getenv("METRICS_PORT"); mp != "" {
 		portNum, err := strconv.Atoi(mp)
 		if err != nil {
@@ -117,6 +142,141 @@ func loadConfig() (*Config, error) {
 		slog.Warn("DATABASE_CLEANUP_FREQ environment variable not set, using default value 5m")
 	}
 
+	keyCacheSize := 10000
+	if kcs := os.Getenv("KEY_CACHE_SIZE"); kcs != "" {
+		kcsNum, err := strconv.Atoi(kcs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid KEY_CACHE_SIZE value: %w", err)
+		}
+		keyCacheSize = kcsNum
+	}
+
+	tokenHashSalt := os.Getenv("TOKEN_HASH_SALT")
+	if tokenHashSalt == "" {
+		slog.Warn("TOKEN_HASH_SALT environment variable not set, using a random salt")
+	}
+
+	sessionKeys, err := session.ParseKeys(os.Getenv("SESSION_SIGNING_KEYS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SESSION_SIGNING_KEYS value: %w", err)
+	}
+	if len(sessionKeys) == 0 {
+		slog.Warn("SESSION_SIGNING_KEYS environment variable not set, sessions will not survive a restart")
+		sessionKeys = []session.Key{session.RandomKey()}
+	}
+
+	sessionTTL := time.Hour
+	if st := os.Getenv("SESSION_TTL"); st != "" {
+		stDuration, err := time.ParseDuration(st)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SESSION_TTL value: %w", err)
+		}
+		sessionTTL = stDuration
+	}
+
+	sessionRefreshTTL := 7 * 24 * time.Hour
+	if srt := os.Getenv("SESSION_REFRESH_TTL"); srt != "" {
+		srtDuration, err := time.ParseDuration(srt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SESSION_REFRESH_TTL value: %w", err)
+		}
+		sessionRefreshTTL = srtDuration
+	}
+
+	publicRead := os.Getenv("PUBLIC_READ") == "true"
+
+	reportHideThreshold := 3
+	if rht := os.Getenv("REPORT_HIDE_THRESHOLD"); rht != "" {
+		rhtNum, err := strconv.Atoi(rht)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REPORT_HIDE_THRESHOLD value: %w", err)
+		}
+		reportHideThreshold = rhtNum
+	}
+
+	groupFormingLead := 30 * time.Minute
+	if gfl := os.Getenv("GROUP_FORMING_LEAD"); gfl != "" {
+		gflDuration, err := time.ParseDuration(gfl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GROUP_FORMING_LEAD value: %w", err)
+		}
+		groupFormingLead = gflDuration
+	}
+
+	groupCloseAfter := time.Hour
+	if gca := os.Getenv("GROUP_CLOSE_AFTER"); gca != "" {
+		gcaDuration, err := time.ParseDuration(gca)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GROUP_CLOSE_AFTER value: %w", err)
+		}
+		groupCloseAfter = gcaDuration
+	}
+
+	titleMaxLength := 100
+	if tml := os.Getenv("TITLE_MAX_LENGTH"); tml != "" {
+		tmlNum, err := strconv.Atoi(tml)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TITLE_MAX_LENGTH value: %w", err)
+		}
+		titleMaxLength = tmlNum
+	}
+
+	blocklistPath := os.Getenv("CONTENT_BLOCKLIST_PATH")
+	if blocklistPath == "" {
+		slog.Warn("CONTENT_BLOCKLIST_PATH environment variable not set, no words will be blocked")
+	}
+
+	// Links are common for voice chat invites, so only flag them by default
+	contentActionsEnv := "url=flag"
+	if ca := os.Getenv("CONTENT_POLICY_ACTIONS"); ca != "" {
+		contentActionsEnv = ca
+	}
+	contentActions, err := contentpolicy.ParseActions(contentActionsEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CONTENT_POLICY_ACTIONS value: %w", err)
+	}
+
+	// Heartbeats get their own bucket so they don't eat into the budget
+	// for writes
+	rateLimits := ratelimit.Config{
+		Default: ratelimit.Limit{RequestsPerSecond: 1, Burst: 20},
+		Methods: map[string]ratelimit.Limit{
+			pb.LfgService_Heartbeat_FullMethodName: {RequestsPerSecond: 0.5, Burst: 5},
+		},
+	}
+	if rlc := os.Getenv("RATE_LIMIT_CONFIG"); rlc != "" {
+		rateLimits, err = ratelimit.LoadConfig(rlc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_CONFIG value: %w", err)
+		}
+	}
+
+	trustedProxiesEnv := os.Getenv("TRUSTED_PROXIES")
+	if trustedProxiesEnv == "" && os.Getenv("RENDER") != "" {
+		// Render's load balancers connect from its private network
+		trustedProxiesEnv = "10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,127.0.0.0/8,::1"
+	}
+	trustedProxies, err := ratelimit.ParsePrefixes(trustedProxiesEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRUSTED_PROXIES value: %w", err)
+	}
+
+	maxStreams := 5
+	if ms := os.Getenv("MAX_STREAMS_PER_ACCOUNT"); ms != "" {
+		msNum, err := strconv.Atoi(ms)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAX_STREAMS_PER_ACCOUNT value: %w", err)
+		}
+		maxStreams = msNum
+	}
+
+	var admins []string
+	for _, admin := range strings.Split(os.Getenv("ADMIN_ACCOUNTS"), ",") {
+		if admin = strings.TrimSpace(admin); admin != "" {
+			admins = append(admins, admin)
+		}
+	}
+
 	return &Config{
 		Host:                host,
 		Port:                port,
@@ -130,9 +290,43 @@ func loadConfig() (*Config, error) {
 		DatabasePath:        dbPath,
 		DatabaseEntryTTL:    dbEntryTTL,
 		DatabaseCleanupFreq: dbCleanupFreq,
+		TokenHashSalt:       tokenHashSalt,
+		KeyCacheSize:        keyCacheSize,
+		SessionKeys:         sessionKeys,
+		SessionTTL:          sessionTTL,
+		SessionRefreshTTL:   sessionRefreshTTL,
+		PublicRead:          publicRead,
+		ReportHideThreshold: reportHideThreshold,
+		GroupFormingLead:    groupFormingLead,
+		GroupCloseAfter:     groupCloseAfter,
+		TitleMaxLength:      titleMaxLength,
+		BlocklistPath:       blocklistPath,
+		ContentActions:      contentActions,
+		RateLimits:          rateLimits,
+		TrustedProxies:      trustedProxies,
+		MaxStreamsPerClient: maxStreams,
+		Admins:              admins,
 	}, nil
 }
 
+// authPolicies lists the methods that don't follow the default of requiring
+// a valid key or session token.
+func authPolicies(config *Config) authenticator.Policies {
+	policies := authenticator.Policies{
+		"/grpc.health.v1.Health/":                           authenticator.Public,
+		"/grpc.reflection.v1.ServerReflection/":             authenticator.Public,
+		"/grpc.reflection.v1alpha.ServerReflection/":        authenticator.Public,
+		"/" + pb.AuthService_ServiceDesc.ServiceName + "/":  authenticator.Public,
+		"/" + pb.AdminService_ServiceDesc.ServiceName + "/": authenticator.Admin,
+	}
+	if config.PublicRead {
+		policies[pb.LfgService_ListGroups_FullMethodName] = authenticator.Optional
+		policies[pb.LfgService_GetGroup_FullMethodName] = authenticator.Optional
+		policies[pb.LfgService_SubscribeGroups_FullMethodName] = authenticator.Optional
+	}
+	return policies
+}
+
 func setupHealthCheck(grpcServer *grpc.Server) {
 	healthServer := health.NewServer()
 	healthpb.RegisterHealthServer(grpcServer, healthServer)
@@ -148,6 +342,9 @@ func InterceptorLogger(l *slog.Logger) logging.Logger {
 }
 
 func main() {
+	// Make sure no API key ever ends up in the logs
+	slog.SetDefault(slog.New(redact.NewHandler(slog.NewTextHandler(os.Stderr, nil))))
+
 	// Load configuration
 	config, err := loadConfig()
 	if err != nil {
@@ -170,15 +367,62 @@ func main() {
 		Timeout:           config.KeepAliveTimeout,
 	}
 
-	keyResolver := keyresolver.New()
-	authenticator := authenticator.New(keyResolver)
+	hasher := tokenhash.New([]byte(config.TokenHashSalt))
+	keyResolver := keyresolver.New(keyresolver.Config{CacheSize: config.KeyCacheSize}, hasher)
+	signer, err := session.NewSigner(config.SessionKeys...)
+	if err != nil {
+		slog.Error("Failed to initialize session signer", "error", err)
+		return
+	}
+	authenticator := authenticator.New(authenticator.Config{
+		Policies: authPolicies(config),
+		Admins:   config.Admins,
+		Bans:     db,
+		ReadMethods: map[string]bool{
+			pb.LfgService_ListGroups_FullMethodName:                 true,
+			pb.LfgService_GetGroup_FullMethodName:                   true,
+			pb.LfgService_SubscribeGroups_FullMethodName:            true,
+			pb.LfgService_ListGroupApplications_FullMethodName:      true,
+			pb.LfgService_SubscribeGroupApplications_FullMethodName: true,
+			pb.LfgService_ListBlocked_FullMethodName:                true,
+			pb.LfgService_ListWhitelisted_FullMethodName:            true,
+			pb.LfgService_ListGroupTemplates_FullMethodName:         true,
+			pb.LfgService_ListInvitations_FullMethodName:            true,
+		},
+	}, keyResolver, signer, hasher)
 	kpClient := kpme.NewClient()
 	// Create unary/stream rateLimiters, based on token bucket here.
 	// You can implement your own rate-limiter for the interface.
-	limiter := ratelimit.NewRateLimiter(ratelimit.Config{
-		RequestsPerSecond: 1,
-		Burst:             20,
-		CleanupInterval:   time.Minute,
+	rateLimits := config.RateLimits
+	rateLimits.CleanupInterval = time.Minute
+	limiter := ratelimit.NewRateLimiter(rateLimits)
+	// Limit by address before authenticating, so that unauthenticated calls
+	// and failed authentications are limited too. Login resolves keys
+	// itself, so it gets a stricter budget.
+	peerLimiter := ratelimit.NewRateLimiter(ratelimit.Config{
+		Default: ratelimit.Limit{RequestsPerSecond: 10, Burst: 100},
+		Methods: map[string]ratelimit.Limit{
+			pb.AuthService_Login_FullMethodName: {RequestsPerSecond: 0.2, Burst: 10},
+			// Calendar apps poll feeds rarely, but each feed reads all groups
+			calendarPathPrefix: {RequestsPerSecond: 0.1, Burst: 10},
+		},
+		CleanupInterval: time.Minute,
+		ByPeer:          true,
+		TrustedProxies:  config.TrustedProxies,
+		HideQuota:       true,
+	})
+	authFailureLimiter := ratelimit.NewRateLimiter(ratelimit.Config{
+		Default:         ratelimit.Limit{RequestsPerSecond: 0.05, Burst: 10},
+		CleanupInterval: time.Minute,
+		IdleTimeout:     10 * time.Minute,
+		ByPeer:          true,
+		TrustedProxies:  config.TrustedProxies,
+	})
+	authenticate := authFailureLimiter.LimitFailures(authenticator.Authenticate)
+	streamLimiter := ratelimit.NewStreamLimiter(ratelimit.StreamConfig{
+		MaxStreams:      config.MaxStreamsPerClient,
+		Opens:           ratelimit.Limit{RequestsPerSecond: 0.2, Burst: 10},
+		CleanupInterval: time.Minute,
 	})
 
 	loggingOpts := []logging.Option{
@@ -200,22 +444,43 @@ func main() {
 		grpc.MaxSendMsgSize(config.MaxSendMsgSize),
 		grpc.MaxConcurrentStreams(uint32(config.MaxConcurrentConns)),
 		grpc.ChainUnaryInterceptor(
+			redact.UnaryServerInterceptor(),
 			logging.UnaryServerInterceptor(InterceptorLogger(slog.Default()), loggingOpts...),
-			grpc_auth.UnaryServerInterceptor(authenticator.Authenticate),
+			grpc_ratelimit.UnaryServerInterceptor(peerLimiter),
+			grpc_auth.UnaryServerInterceptor(authenticate),
 			grpc_ratelimit.UnaryServerInterceptor(limiter),
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 		),
 		grpc.ChainStreamInterceptor(
+			redact.StreamServerInterceptor(),
 			logging.StreamServerInterceptor(InterceptorLogger(slog.Default()), loggingOpts...),
-			grpc_auth.StreamServerInterceptor(authenticator.Authenticate),
+			grpc_ratelimit.StreamServerInterceptor(peerLimiter),
+			grpc_auth.StreamServerInterceptor(authenticate),
 			grpc_ratelimit.StreamServerInterceptor(limiter),
+			streamLimiter.StreamServerInterceptor(),
 			recovery.StreamServerInterceptor(recoveryOpts...),
 		),
 	)
 
 	// Create and register the LFG service
-	server := NewServer(db, kpClient)
+	contentPolicy, err := contentpolicy.New(contentpolicy.Config{
+		MaxLength:     config.TitleMaxLength,
+		MaxRepeat:     5,
+		BlocklistPath: config.BlocklistPath,
+		Actions:       config.ContentActions,
+	})
+	if err != nil {
+		slog.Error("Failed to load content policy", "error", err)
+		return
+	}
+	server := NewServer(ServerConfig{
+		ReportHideThreshold: config.ReportHideThreshold,
+		FormingLead:         config.GroupFormingLead,
+		CloseAfter:          config.GroupCloseAfter,
+	}, db, contentPolicy, kpClient)
 	pb.RegisterLfgServiceServer(grpcServer, server)
+	pb.RegisterAuthServiceServer(grpcServer, NewAuthServer(keyResolver, signer, db, config.SessionTTL, config.SessionRefreshTTL))
+	pb.RegisterAdminServiceServer(grpcServer, NewAdminServer(db, server))
 
 	// Setup health check required by Render
 	setupHealthCheck(grpcServer)
@@ -226,9 +491,22 @@ func main() {
 	}
 
 	// Start metrics server if enabled
+	expvar.Publish("keyresolver", expvar.Func(func() any { return keyResolver.Stats() }))
+	expvar.Publish("ratelimit", expvar.Func(func() any { return limiter.Stats() }))
+	expvar.Publish("ratelimit_peer", expvar.Func(func() any { return peerLimiter.Stats() }))
+	expvar.Publish("ratelimit_auth_failures", expvar.Func(func() any { return authFailureLimiter.Stats() }))
+	expvar.Publish("streams", expvar.Func(func() any { return streamLimiter.Stats() }))
 	if config.MetricsPort > 0 {
+		metricsServer := &http.Server{
+			Addr:    fmt.Sprintf("%s:%d", config.Host, config.MetricsPort),
+			Handler: expvar.Handler(),
+		}
 		go func() {
+			if err := metricsServer.ListenAndServe(); err != http.ErrServerClosed {
+				slog.Error("Metrics server error", "error", err)
+			}
 		}()
+		defer metricsServer.Close()
 	}
 
 	// Setup signal handling
@@ -240,6 +518,8 @@ func main() {
 			server.CleanUpExpiredDatabaseEntries(ctx, config.DatabaseEntryTTL, config.DatabaseCleanupFreq)
 		}()
 	}
+	go contentPolicy.Watch(ctx, 30*time.Second)
+	go server.RunScheduler(ctx, 30*time.Second)
 
 	wrappedGrpc := grpcweb.WrapServer(grpcServer,
 		grpcweb.WithOriginFunc(func(origin string) bool {
@@ -249,10 +529,15 @@ func main() {
 		grpcweb.WithAllowedRequestHeaders([]string{"*"}),
 	)
 
+	// Calendar feeds are plain HTTP, everything else goes to gRPC
+	mux := http.NewServeMux()
+	mux.Handle(calendarPathPrefix, peerLimiter.Handler(calendarPathPrefix, server.CalendarHandler()))
+	mux.Handle("/", wrappedGrpc)
+
 	// Start HTTP server
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", config.Host, config.Port),
-		Handler: wrappedGrpc,
+		Handler: mux,
 	}
 
 	// Channel to receive shutdown signal
@@ -295,6 +580,10 @@ func main() {
 
 	// Stop gRPC server gracefully
 	grpcServer.GracefulStop()
+	limiter.Close()
+	peerLimiter.Close()
+	authFailureLimiter.Close()
+	streamLimiter.Close()
 
 	// Close database connection
 	if err := db.Close(); err != nil {
@@ -84,83 +84,4602 @@ func (RaidId) EnumDescriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{0}
 }
 
+type GroupVisibility int32
+
+const (
+	GroupVisibility_VISIBILITY_PUBLIC GroupVisibility = 0
+	// Only visible to members of the group's guilds.
+	GroupVisibility_VISIBILITY_GUILD GroupVisibility = 1
+	// Not listed, only reachable by invite code.
+	GroupVisibility_VISIBILITY_UNLISTED GroupVisibility = 2
+)
+
+// Enum value maps for GroupVisibility.
+var (
+	GroupVisibility_name = map[int32]string{
+		0: "VISIBILITY_PUBLIC",
+		1: "VISIBILITY_GUILD",
+		2: "VISIBILITY_UNLISTED",
+	}
+	GroupVisibility_value = map[string]int32{
+		"VISIBILITY_PUBLIC":   0,
+		"VISIBILITY_GUILD":    1,
+		"VISIBILITY_UNLISTED": 2,
+	}
+)
+
+func (x GroupVisibility) Enum() *GroupVisibility {
+	p := new(GroupVisibility)
+	*p = x
+	return p
+}
+
+func (x GroupVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupVisibility) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[1]
+}
+
+func (x GroupVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupVisibility.Descriptor instead.
+func (GroupVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{1}
+}
+
+type GroupState int32
+
+const (
+	GroupState_GROUP_STATE_UNSPECIFIED GroupState = 0
+	// Posted ahead of its start time.
+	GroupState_GROUP_STATE_SCHEDULED GroupState = 1
+	// Starting soon or right away, looking for members.
+	GroupState_GROUP_STATE_FORMING     GroupState = 2
+	GroupState_GROUP_STATE_IN_PROGRESS GroupState = 3
+	// No longer accepting applications.
+	GroupState_GROUP_STATE_CLOSED GroupState = 4
+)
+
+// Enum value maps for GroupState.
+var (
+	GroupState_name = map[int32]string{
+		0: "GROUP_STATE_UNSPECIFIED",
+		1: "GROUP_STATE_SCHEDULED",
+		2: "GROUP_STATE_FORMING",
+		3: "GROUP_STATE_IN_PROGRESS",
+		4: "GROUP_STATE_CLOSED",
+	}
+	GroupState_value = map[string]int32{
+		"GROUP_STATE_UNSPECIFIED": 0,
+		"GROUP_STATE_SCHEDULED":   1,
+		"GROUP_STATE_FORMING":     2,
+		"GROUP_STATE_IN_PROGRESS": 3,
+		"GROUP_STATE_CLOSED":      4,
+	}
+)
+
+func (x GroupState) Enum() *GroupState {
+	p := new(GroupState)
+	*p = x
+	return p
+}
+
+func (x GroupState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupState) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[2].Descriptor()
+}
+
+func (GroupState) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[2]
+}
+
+func (x GroupState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupState.Descriptor instead.
+func (GroupState) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{2}
+}
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_SUNDAY    Weekday = 0
+	Weekday_WEEKDAY_MONDAY    Weekday = 1
+	Weekday_WEEKDAY_TUESDAY   Weekday = 2
+	Weekday_WEEKDAY_WEDNESDAY Weekday = 3
+	Weekday_WEEKDAY_THURSDAY  Weekday = 4
+	Weekday_WEEKDAY_FRIDAY    Weekday = 5
+	Weekday_WEEKDAY_SATURDAY  Weekday = 6
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_SUNDAY",
+		1: "WEEKDAY_MONDAY",
+		2: "WEEKDAY_TUESDAY",
+		3: "WEEKDAY_WEDNESDAY",
+		4: "WEEKDAY_THURSDAY",
+		5: "WEEKDAY_FRIDAY",
+		6: "WEEKDAY_SATURDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_SUNDAY":    0,
+		"WEEKDAY_MONDAY":    1,
+		"WEEKDAY_TUESDAY":   2,
+		"WEEKDAY_WEDNESDAY": 3,
+		"WEEKDAY_THURSDAY":  4,
+		"WEEKDAY_FRIDAY":    5,
+		"WEEKDAY_SATURDAY":  6,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[3].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[3]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{3}
+}
+
+type GroupSort int32
+
+const (
+	// Most recently updated first.
+	GroupSort_GROUP_SORT_UPDATED GroupSort = 0
+	// Soonest start first.
+	GroupSort_GROUP_SORT_START_TIME GroupSort = 1
+)
+
+// Enum value maps for GroupSort.
+var (
+	GroupSort_name = map[int32]string{
+		0: "GROUP_SORT_UPDATED",
+		1: "GROUP_SORT_START_TIME",
+	}
+	GroupSort_value = map[string]int32{
+		"GROUP_SORT_UPDATED":    0,
+		"GROUP_SORT_START_TIME": 1,
+	}
+)
+
+func (x GroupSort) Enum() *GroupSort {
+	p := new(GroupSort)
+	*p = x
+	return p
+}
+
+func (x GroupSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[4].Descriptor()
+}
+
+func (GroupSort) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[4]
+}
+
+func (x GroupSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupSort.Descriptor instead.
+func (GroupSort) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ReportTargetType int32
+
+const (
+	ReportTargetType_REPORT_TARGET_UNKNOWN     ReportTargetType = 0
+	ReportTargetType_REPORT_TARGET_GROUP       ReportTargetType = 1
+	ReportTargetType_REPORT_TARGET_APPLICATION ReportTargetType = 2
+)
+
+// Enum value maps for ReportTargetType.
+var (
+	ReportTargetType_name = map[int32]string{
+		0: "REPORT_TARGET_UNKNOWN",
+		1: "REPORT_TARGET_GROUP",
+		2: "REPORT_TARGET_APPLICATION",
+	}
+	ReportTargetType_value = map[string]int32{
+		"REPORT_TARGET_UNKNOWN":     0,
+		"REPORT_TARGET_GROUP":       1,
+		"REPORT_TARGET_APPLICATION": 2,
+	}
+)
+
+func (x ReportTargetType) Enum() *ReportTargetType {
+	p := new(ReportTargetType)
+	*p = x
+	return p
+}
+
+func (x ReportTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[5].Descriptor()
+}
+
+func (ReportTargetType) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[5]
+}
+
+func (x ReportTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTargetType.Descriptor instead.
+func (ReportTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+type AutoAcceptMode int32
+
+const (
+	AutoAcceptMode_AUTO_ACCEPT_OFF AutoAcceptMode = 0
+	// Accept applicants meeting the group's kill proof requirement.
+	AutoAcceptMode_AUTO_ACCEPT_KILL_PROOF AutoAcceptMode = 1
+	// Accept applicants in order until the group is full.
+	AutoAcceptMode_AUTO_ACCEPT_FIRST_COME AutoAcceptMode = 2
+	// Accept applicants on the commander's whitelist.
+	AutoAcceptMode_AUTO_ACCEPT_WHITELIST AutoAcceptMode = 3
+)
+
+// Enum value maps for AutoAcceptMode.
+var (
+	AutoAcceptMode_name = map[int32]string{
+		0: "AUTO_ACCEPT_OFF",
+		1: "AUTO_ACCEPT_KILL_PROOF",
+		2: "AUTO_ACCEPT_FIRST_COME",
+		3: "AUTO_ACCEPT_WHITELIST",
+	}
+	AutoAcceptMode_value = map[string]int32{
+		"AUTO_ACCEPT_OFF":        0,
+		"AUTO_ACCEPT_KILL_PROOF": 1,
+		"AUTO_ACCEPT_FIRST_COME": 2,
+		"AUTO_ACCEPT_WHITELIST":  3,
+	}
+)
+
+func (x AutoAcceptMode) Enum() *AutoAcceptMode {
+	p := new(AutoAcceptMode)
+	*p = x
+	return p
+}
+
+func (x AutoAcceptMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AutoAcceptMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[6].Descriptor()
+}
+
+func (AutoAcceptMode) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[6]
+}
+
+func (x AutoAcceptMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AutoAcceptMode.Descriptor instead.
+func (AutoAcceptMode) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_APPLICATION_STATUS_PENDING  ApplicationStatus = 0
+	ApplicationStatus_APPLICATION_STATUS_ACCEPTED ApplicationStatus = 1
+	ApplicationStatus_APPLICATION_STATUS_DECLINED ApplicationStatus = 2
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "APPLICATION_STATUS_PENDING",
+		1: "APPLICATION_STATUS_ACCEPTED",
+		2: "APPLICATION_STATUS_DECLINED",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"APPLICATION_STATUS_PENDING":  0,
+		"APPLICATION_STATUS_ACCEPTED": 1,
+		"APPLICATION_STATUS_DECLINED": 2,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[7]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_DPS         Role = 1
+	Role_ROLE_BOON_DPS    Role = 2
+	Role_ROLE_HEAL        Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_DPS",
+		2: "ROLE_BOON_DPS",
+		3: "ROLE_HEAL",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_DPS":         1,
+		"ROLE_BOON_DPS":    2,
+		"ROLE_HEAL":        3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[8].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[8]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+type Boon int32
+
+const (
+	Boon_BOON_UNSPECIFIED Boon = 0
+	Boon_BOON_QUICKNESS   Boon = 1
+	Boon_BOON_ALACRITY    Boon = 2
+)
+
+// Enum value maps for Boon.
+var (
+	Boon_name = map[int32]string{
+		0: "BOON_UNSPECIFIED",
+		1: "BOON_QUICKNESS",
+		2: "BOON_ALACRITY",
+	}
+	Boon_value = map[string]int32{
+		"BOON_UNSPECIFIED": 0,
+		"BOON_QUICKNESS":   1,
+		"BOON_ALACRITY":    2,
+	}
+)
+
+func (x Boon) Enum() *Boon {
+	p := new(Boon)
+	*p = x
+	return p
+}
+
+func (x Boon) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Boon) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[9].Descriptor()
+}
+
+func (Boon) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[9]
+}
+
+func (x Boon) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Boon.Descriptor instead.
+func (Boon) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{9}
+}
+
+type Profession int32
+
+const (
+	Profession_PROFESSION_UNSPECIFIED  Profession = 0
+	Profession_PROFESSION_GUARDIAN     Profession = 1
+	Profession_PROFESSION_WARRIOR      Profession = 2
+	Profession_PROFESSION_ENGINEER     Profession = 3
+	Profession_PROFESSION_RANGER       Profession = 4
+	Profession_PROFESSION_THIEF        Profession = 5
+	Profession_PROFESSION_ELEMENTALIST Profession = 6
+	Profession_PROFESSION_MESMER       Profession = 7
+	Profession_PROFESSION_NECROMANCER  Profession = 8
+	Profession_PROFESSION_REVENANT     Profession = 9
+)
+
+// Enum value maps for Profession.
+var (
+	Profession_name = map[int32]string{
+		0: "PROFESSION_UNSPECIFIED",
+		1: "PROFESSION_GUARDIAN",
+		2: "PROFESSION_WARRIOR",
+		3: "PROFESSION_ENGINEER",
+		4: "PROFESSION_RANGER",
+		5: "PROFESSION_THIEF",
+		6: "PROFESSION_ELEMENTALIST",
+		7: "PROFESSION_MESMER",
+		8: "PROFESSION_NECROMANCER",
+		9: "PROFESSION_REVENANT",
+	}
+	Profession_value = map[string]int32{
+		"PROFESSION_UNSPECIFIED":  0,
+		"PROFESSION_GUARDIAN":     1,
+		"PROFESSION_WARRIOR":      2,
+		"PROFESSION_ENGINEER":     3,
+		"PROFESSION_RANGER":       4,
+		"PROFESSION_THIEF":        5,
+		"PROFESSION_ELEMENTALIST": 6,
+		"PROFESSION_MESMER":       7,
+		"PROFESSION_NECROMANCER":  8,
+		"PROFESSION_REVENANT":     9,
+	}
+)
+
+func (x Profession) Enum() *Profession {
+	p := new(Profession)
+	*p = x
+	return p
+}
+
+func (x Profession) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Profession) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[10].Descriptor()
+}
+
+func (Profession) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[10]
+}
+
+func (x Profession) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Profession.Descriptor instead.
+func (Profession) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{10}
+}
+
+type EliteSpec int32
+
+const (
+	EliteSpec_ELITE_SPEC_UNSPECIFIED  EliteSpec = 0
+	EliteSpec_ELITE_SPEC_DRAGONHUNTER EliteSpec = 1
+	EliteSpec_ELITE_SPEC_FIREBRAND    EliteSpec = 2
+	EliteSpec_ELITE_SPEC_WILLBENDER   EliteSpec = 3
+	EliteSpec_ELITE_SPEC_BERSERKER    EliteSpec = 4
+	EliteSpec_ELITE_SPEC_SPELLBREAKER EliteSpec = 5
+	EliteSpec_ELITE_SPEC_BLADESWORN   EliteSpec = 6
+	EliteSpec_ELITE_SPEC_SCRAPPER     EliteSpec = 7
+	EliteSpec_ELITE_SPEC_HOLOSMITH    EliteSpec = 8
+	EliteSpec_ELITE_SPEC_MECHANIST    EliteSpec = 9
+	EliteSpec_ELITE_SPEC_DRUID        EliteSpec = 10
+	EliteSpec_ELITE_SPEC_SOULBEAST    EliteSpec = 11
+	EliteSpec_ELITE_SPEC_UNTAMED      EliteSpec = 12
+	EliteSpec_ELITE_SPEC_DAREDEVIL    EliteSpec = 13
+	EliteSpec_ELITE_SPEC_DEADEYE      EliteSpec = 14
+	EliteSpec_ELITE_SPEC_SPECTER      EliteSpec = 15
+	EliteSpec_ELITE_SPEC_TEMPEST      EliteSpec = 16
+	EliteSpec_ELITE_SPEC_WEAVER       EliteSpec = 17
+	EliteSpec_ELITE_SPEC_CATALYST     EliteSpec = 18
+	EliteSpec_ELITE_SPEC_CHRONOMANCER EliteSpec = 19
+	EliteSpec_ELITE_SPEC_MIRAGE       EliteSpec = 20
+	EliteSpec_ELITE_SPEC_VIRTUOSO     EliteSpec = 21
+	EliteSpec_ELITE_SPEC_REAPER       EliteSpec = 22
+	EliteSpec_ELITE_SPEC_SCOURGE      EliteSpec = 23
+	EliteSpec_ELITE_SPEC_HARBINGER    EliteSpec = 24
+	EliteSpec_ELITE_SPEC_HERALD       EliteSpec = 25
+	EliteSpec_ELITE_SPEC_RENEGADE     EliteSpec = 26
+	EliteSpec_ELITE_SPEC_VINDICATOR   EliteSpec = 27
+)
+
+// Enum value maps for EliteSpec.
+var (
+	EliteSpec_name = map[int32]string{
+		0:  "ELITE_SPEC_UNSPECIFIED",
+		1:  "ELITE_SPEC_DRAGONHUNTER",
+		2:  "ELITE_SPEC_FIREBRAND",
+		3:  "ELITE_SPEC_WILLBENDER",
+		4:  "ELITE_SPEC_BERSERKER",
+		5:  "ELITE_SPEC_SPELLBREAKER",
+		6:  "ELITE_SPEC_BLADESWORN",
+		7:  "ELITE_SPEC_SCRAPPER",
+		8:  "ELITE_SPEC_HOLOSMITH",
+		9:  "ELITE_SPEC_MECHANIST",
+		10: "ELITE_SPEC_DRUID",
+		11: "ELITE_SPEC_SOULBEAST",
+		12: "ELITE_SPEC_UNTAMED",
+		13: "ELITE_SPEC_DAREDEVIL",
+		14: "ELITE_SPEC_DEADEYE",
+		15: "ELITE_SPEC_SPECTER",
+		16: "ELITE_SPEC_TEMPEST",
+		17: "ELITE_SPEC_WEAVER",
+		18: "ELITE_SPEC_CATALYST",
+		19: "ELITE_SPEC_CHRONOMANCER",
+		20: "ELITE_SPEC_MIRAGE",
+		21: "ELITE_SPEC_VIRTUOSO",
+		22: "ELITE_SPEC_REAPER",
+		23: "ELITE_SPEC_SCOURGE",
+		24: "ELITE_SPEC_HARBINGER",
+		25: "ELITE_SPEC_HERALD",
+		26: "ELITE_SPEC_RENEGADE",
+		27: "ELITE_SPEC_VINDICATOR",
+	}
+	EliteSpec_value = map[string]int32{
+		"ELITE_SPEC_UNSPECIFIED":  0,
+		"ELITE_SPEC_DRAGONHUNTER": 1,
+		"ELITE_SPEC_FIREBRAND":    2,
+		"ELITE_SPEC_WILLBENDER":   3,
+		"ELITE_SPEC_BERSERKER":    4,
+		"ELITE_SPEC_SPELLBREAKER": 5,
+		"ELITE_SPEC_BLADESWORN":   6,
+		"ELITE_SPEC_SCRAPPER":     7,
+		"ELITE_SPEC_HOLOSMITH":    8,
+		"ELITE_SPEC_MECHANIST":    9,
+		"ELITE_SPEC_DRUID":        10,
+		"ELITE_SPEC_SOULBEAST":    11,
+		"ELITE_SPEC_UNTAMED":      12,
+		"ELITE_SPEC_DAREDEVIL":    13,
+		"ELITE_SPEC_DEADEYE":      14,
+		"ELITE_SPEC_SPECTER":      15,
+		"ELITE_SPEC_TEMPEST":      16,
+		"ELITE_SPEC_WEAVER":       17,
+		"ELITE_SPEC_CATALYST":     18,
+		"ELITE_SPEC_CHRONOMANCER": 19,
+		"ELITE_SPEC_MIRAGE":       20,
+		"ELITE_SPEC_VIRTUOSO":     21,
+		"ELITE_SPEC_REAPER":       22,
+		"ELITE_SPEC_SCOURGE":      23,
+		"ELITE_SPEC_HARBINGER":    24,
+		"ELITE_SPEC_HERALD":       25,
+		"ELITE_SPEC_RENEGADE":     26,
+		"ELITE_SPEC_VINDICATOR":   27,
+	}
+)
+
+func (x EliteSpec) Enum() *EliteSpec {
+	p := new(EliteSpec)
+	*p = x
+	return p
+}
+
+func (x EliteSpec) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EliteSpec) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[11].Descriptor()
+}
+
+func (EliteSpec) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[11]
+}
+
+func (x EliteSpec) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EliteSpec.Descriptor instead.
+func (EliteSpec) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{11}
+}
+
 type KillProofId int32
 
-const (
-	KillProofId_KP_UNKNOWN KillProofId = 0
-	KillProofId_KP_LI      KillProofId = 1
-	KillProofId_KP_BSKP    KillProofId = 2
-	KillProofId_KP_UFE     KillProofId = 3
-)
+const (
+	KillProofId_KP_UNKNOWN KillProofId = 0
+	KillProofId_KP_LI      KillProofId = 1
+	KillProofId_KP_BSKP    KillProofId = 2
+	KillProofId_KP_UFE     KillProofId = 3
+)
+
+// Enum value maps for KillProofId.
+var (
+	KillProofId_name = map[int32]string{
+		0: "KP_UNKNOWN",
+		1: "KP_LI",
+		2: "KP_BSKP",
+		3: "KP_UFE",
+	}
+	KillProofId_value = map[string]int32{
+		"KP_UNKNOWN": 0,
+		"KP_LI":      1,
+		"KP_BSKP":    2,
+		"KP_UFE":     3,
+	}
+)
+
+func (x KillProofId) Enum() *KillProofId {
+	p := new(KillProofId)
+	*p = x
+	return p
+}
+
+func (x KillProofId) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KillProofId) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[12].Descriptor()
+}
+
+func (KillProofId) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[12]
+}
+
+func (x KillProofId) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KillProofId.Descriptor instead.
+func (KillProofId) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{12}
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title            string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	KillProofId      KillProofId     `protobuf:"varint,2,opt,name=kill_proof_id,json=killProofId,proto3,enum=gw2lfg.KillProofId" json:"kill_proof_id,omitempty"`
+	KillProofMinimum uint32          `protobuf:"varint,3,opt,name=kill_proof_minimum,json=killProofMinimum,proto3" json:"kill_proof_minimum,omitempty"`
+	RequiredAccess   []string        `protobuf:"bytes,4,rep,name=required_access,json=requiredAccess,proto3" json:"required_access,omitempty"`
+	RequireCommander bool            `protobuf:"varint,5,opt,name=require_commander,json=requireCommander,proto3" json:"require_commander,omitempty"`
+	MinFractalLevel  uint32          `protobuf:"varint,6,opt,name=min_fractal_level,json=minFractalLevel,proto3" json:"min_fractal_level,omitempty"`
+	MinAccountAgeSec int64           `protobuf:"varint,7,opt,name=min_account_age_sec,json=minAccountAgeSec,proto3" json:"min_account_age_sec,omitempty"`
+	Visibility       GroupVisibility `protobuf:"varint,8,opt,name=visibility,proto3,enum=gw2lfg.GroupVisibility" json:"visibility,omitempty"`
+	GuildIds         []string        `protobuf:"bytes,9,rep,name=guild_ids,json=guildIds,proto3" json:"guild_ids,omitempty"`
+	// Zero for groups starting right away.
+	StartAtSec int64       `protobuf:"varint,10,opt,name=start_at_sec,json=startAtSec,proto3" json:"start_at_sec,omitempty"`
+	Slots      []*RoleSlot `protobuf:"bytes,11,rep,name=slots,proto3" json:"slots,omitempty"`
+	AutoAccept *AutoAccept `protobuf:"bytes,12,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateGroupRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetKillProofId() KillProofId {
+	if x != nil {
+		return x.KillProofId
+	}
+	return KillProofId_KP_UNKNOWN
+}
+
+func (x *CreateGroupRequest) GetKillProofMinimum() uint32 {
+	if x != nil {
+		return x.KillProofMinimum
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetRequiredAccess() []string {
+	if x != nil {
+		return x.RequiredAccess
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetRequireCommander() bool {
+	if x != nil {
+		return x.RequireCommander
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetMinFractalLevel() uint32 {
+	if x != nil {
+		return x.MinFractalLevel
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetMinAccountAgeSec() int64 {
+	if x != nil {
+		return x.MinAccountAgeSec
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetVisibility() GroupVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return GroupVisibility_VISIBILITY_PUBLIC
+}
+
+func (x *CreateGroupRequest) GetGuildIds() []string {
+	if x != nil {
+		return x.GuildIds
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetStartAtSec() int64 {
+	if x != nil {
+		return x.StartAtSec
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetSlots() []*RoleSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetAutoAccept() *AutoAccept {
+	if x != nil {
+		return x.AutoAccept
+	}
+	return nil
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId        string      `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Title            string      `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	KillProofId      KillProofId `protobuf:"varint,4,opt,name=kill_proof_id,json=killProofId,proto3,enum=gw2lfg.KillProofId" json:"kill_proof_id,omitempty"`
+	KillProofMinimum uint32      `protobuf:"varint,5,opt,name=kill_proof_minimum,json=killProofMinimum,proto3" json:"kill_proof_minimum,omitempty"`
+	CreatedAtSec     int64       `protobuf:"varint,6,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	UpdatedAtSec     int64       `protobuf:"varint,7,opt,name=updated_at_sec,json=updatedAtSec,proto3" json:"updated_at_sec,omitempty"`
+	// Requirements on the applicant's account besides kill proof.
+	// Game access as reported by the GW2 API, e.g. "PathOfFire".
+	RequiredAccess   []string `protobuf:"bytes,8,rep,name=required_access,json=requiredAccess,proto3" json:"required_access,omitempty"`
+	RequireCommander bool     `protobuf:"varint,9,opt,name=require_commander,json=requireCommander,proto3" json:"require_commander,omitempty"`
+	MinFractalLevel  uint32   `protobuf:"varint,10,opt,name=min_fractal_level,json=minFractalLevel,proto3" json:"min_fractal_level,omitempty"`
+	// Minimum time played on the account.
+	MinAccountAgeSec int64           `protobuf:"varint,11,opt,name=min_account_age_sec,json=minAccountAgeSec,proto3" json:"min_account_age_sec,omitempty"`
+	Visibility       GroupVisibility `protobuf:"varint,12,opt,name=visibility,proto3,enum=gw2lfg.GroupVisibility" json:"visibility,omitempty"`
+	// Guilds whose members can see a VISIBILITY_GUILD group.
+	GuildIds []string `protobuf:"bytes,13,rep,name=guild_ids,json=guildIds,proto3" json:"guild_ids,omitempty"`
+	// Generated for VISIBILITY_UNLISTED groups, only sent to the creator.
+	InviteCode string `protobuf:"bytes,14,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	// Set after too many reports, only the creator sees the group until an
+	// admin reviews it.
+	Hidden bool `protobuf:"varint,15,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Zero for groups starting right away.
+	StartAtSec int64 `protobuf:"varint,16,opt,name=start_at_sec,json=startAtSec,proto3" json:"start_at_sec,omitempty"`
+	// Maintained by the server based on the start time.
+	State GroupState `protobuf:"varint,17,opt,name=state,proto3,enum=gw2lfg.GroupState" json:"state,omitempty"`
+	// The roles the group is looking for. Without slots, any role can apply.
+	Slots []*RoleSlot `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty"`
+	// Unset to review every application by hand.
+	AutoAccept *AutoAccept `protobuf:"bytes,19,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+	// Last change to the group itself. Unlike updated_at_sec, heartbeats of
+	// the creator leave it alone.
+	ModifiedAtSec int64 `protobuf:"varint,20,opt,name=modified_at_sec,json=modifiedAtSec,proto3" json:"modified_at_sec,omitempty"`
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Group) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Group) GetKillProofId() KillProofId {
+	if x != nil {
+		return x.KillProofId
+	}
+	return KillProofId_KP_UNKNOWN
+}
+
+func (x *Group) GetKillProofMinimum() uint32 {
+	if x != nil {
+		return x.KillProofMinimum
+	}
+	return 0
+}
+
+func (x *Group) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
+	}
+	return 0
+}
+
+func (x *Group) GetUpdatedAtSec() int64 {
+	if x != nil {
+		return x.UpdatedAtSec
+	}
+	return 0
+}
+
+func (x *Group) GetRequiredAccess() []string {
+	if x != nil {
+		return x.RequiredAccess
+	}
+	return nil
+}
+
+func (x *Group) GetRequireCommander() bool {
+	if x != nil {
+		return x.RequireCommander
+	}
+	return false
+}
+
+func (x *Group) GetMinFractalLevel() uint32 {
+	if x != nil {
+		return x.MinFractalLevel
+	}
+	return 0
+}
+
+func (x *Group) GetMinAccountAgeSec() int64 {
+	if x != nil {
+		return x.MinAccountAgeSec
+	}
+	return 0
+}
+
+func (x *Group) GetVisibility() GroupVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return GroupVisibility_VISIBILITY_PUBLIC
+}
+
+func (x *Group) GetGuildIds() []string {
+	if x != nil {
+		return x.GuildIds
+	}
+	return nil
+}
+
+func (x *Group) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *Group) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Group) GetStartAtSec() int64 {
+	if x != nil {
+		return x.StartAtSec
+	}
+	return 0
+}
+
+func (x *Group) GetState() GroupState {
+	if x != nil {
+		return x.State
+	}
+	return GroupState_GROUP_STATE_UNSPECIFIED
+}
+
+func (x *Group) GetSlots() []*RoleSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Group) GetAutoAccept() *AutoAccept {
+	if x != nil {
+		return x.AutoAccept
+	}
+	return nil
+}
+
+func (x *Group) GetModifiedAtSec() int64 {
+	if x != nil {
+		return x.ModifiedAtSec
+	}
+	return 0
+}
+
+// AutoAccept accepts new applications without the commander, into the first
+// open slot matching the applicant's role. Others stay pending for review.
+type AutoAccept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode AutoAcceptMode `protobuf:"varint,1,opt,name=mode,proto3,enum=gw2lfg.AutoAcceptMode" json:"mode,omitempty"`
+	// Most applications accepted at once, zero for no limit besides the slots.
+	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *AutoAccept) Reset() {
+	*x = AutoAccept{}
+	mi := &file_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoAccept) ProtoMessage() {}
+
+func (x *AutoAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoAccept.ProtoReflect.Descriptor instead.
+func (*AutoAccept) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AutoAccept) GetMode() AutoAcceptMode {
+	if x != nil {
+		return x.Mode
+	}
+	return AutoAcceptMode_AUTO_ACCEPT_OFF
+}
+
+func (x *AutoAccept) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+// RoleSlot is a number of places for one role, e.g. two quickness boon DPS.
+type RoleSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Assigned by the server if empty.
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role Role   `protobuf:"varint,2,opt,name=role,proto3,enum=gw2lfg.Role" json:"role,omitempty"`
+	// Any boon if unset.
+	Boon  Boon   `protobuf:"varint,3,opt,name=boon,proto3,enum=gw2lfg.Boon" json:"boon,omitempty"`
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Accepted applications in this slot, maintained by the server.
+	Filled uint32 `protobuf:"varint,5,opt,name=filled,proto3" json:"filled,omitempty"`
+}
+
+func (x *RoleSlot) Reset() {
+	*x = RoleSlot{}
+	mi := &file_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSlot) ProtoMessage() {}
+
+func (x *RoleSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSlot.ProtoReflect.Descriptor instead.
+func (*RoleSlot) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoleSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleSlot) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *RoleSlot) GetBoon() Boon {
+	if x != nil {
+		return x.Boon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+func (x *RoleSlot) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RoleSlot) GetFilled() uint32 {
+	if x != nil {
+		return x.Filled
+	}
+	return 0
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Required to look up VISIBILITY_UNLISTED groups. Enough on its own.
+	InviteCode string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type GetGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateGroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UpdateGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{10}
+}
+
+type SubscribeGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeGroupsRequest) Reset() {
+	*x = SubscribeGroupsRequest{}
+	mi := &file_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGroupsRequest) ProtoMessage() {}
+
+func (x *SubscribeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{11}
+}
+
+type GroupsUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//
+	//	*GroupsUpdate_NewGroup
+	//	*GroupsUpdate_UpdatedGroup
+	//	*GroupsUpdate_RemovedGroupId
+	Update isGroupsUpdate_Update `protobuf_oneof:"update"`
+}
+
+func (x *GroupsUpdate) Reset() {
+	*x = GroupsUpdate{}
+	mi := &file_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupsUpdate) ProtoMessage() {}
+
+func (x *GroupsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupsUpdate.ProtoReflect.Descriptor instead.
+func (*GroupsUpdate) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *GroupsUpdate) GetUpdate() isGroupsUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *GroupsUpdate) GetNewGroup() *Group {
+	if x, ok := x.GetUpdate().(*GroupsUpdate_NewGroup); ok {
+		return x.NewGroup
+	}
+	return nil
+}
+
+func (x *GroupsUpdate) GetUpdatedGroup() *Group {
+	if x, ok := x.GetUpdate().(*GroupsUpdate_UpdatedGroup); ok {
+		return x.UpdatedGroup
+	}
+	return nil
+}
+
+func (x *GroupsUpdate) GetRemovedGroupId() string {
+	if x, ok := x.GetUpdate().(*GroupsUpdate_RemovedGroupId); ok {
+		return x.RemovedGroupId
+	}
+	return ""
+}
+
+type isGroupsUpdate_Update interface {
+	isGroupsUpdate_Update()
+}
+
+type GroupsUpdate_NewGroup struct {
+	NewGroup *Group `protobuf:"bytes,1,opt,name=new_group,json=newGroup,proto3,oneof"`
+}
+
+type GroupsUpdate_UpdatedGroup struct {
+	UpdatedGroup *Group `protobuf:"bytes,2,opt,name=updated_group,json=updatedGroup,proto3,oneof"`
+}
+
+type GroupsUpdate_RemovedGroupId struct {
+	RemovedGroupId string `protobuf:"bytes,3,opt,name=removed_group_id,json=removedGroupId,proto3,oneof"`
+}
+
+func (*GroupsUpdate_NewGroup) isGroupsUpdate_Update() {}
+
+func (*GroupsUpdate_UpdatedGroup) isGroupsUpdate_Update() {}
+
+func (*GroupsUpdate_RemovedGroupId) isGroupsUpdate_Update() {}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return groups whose requirements the caller's account meets.
+	EligibleOnly bool `protobuf:"varint,1,opt,name=eligible_only,json=eligibleOnly,proto3" json:"eligible_only,omitempty"`
+	// Only return groups starting in this range, if set. Groups without a
+	// start time start when they are created.
+	StartAfterSec  int64     `protobuf:"varint,2,opt,name=start_after_sec,json=startAfterSec,proto3" json:"start_after_sec,omitempty"`
+	StartBeforeSec int64     `protobuf:"varint,3,opt,name=start_before_sec,json=startBeforeSec,proto3" json:"start_before_sec,omitempty"`
+	Sort           GroupSort `protobuf:"varint,4,opt,name=sort,proto3,enum=gw2lfg.GroupSort" json:"sort,omitempty"`
+	// Only return groups with an open slot for this role, and boon if set.
+	OpenRole Role `protobuf:"varint,5,opt,name=open_role,json=openRole,proto3,enum=gw2lfg.Role" json:"open_role,omitempty"`
+	OpenBoon Boon `protobuf:"varint,6,opt,name=open_boon,json=openBoon,proto3,enum=gw2lfg.Boon" json:"open_boon,omitempty"`
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListGroupsRequest) GetEligibleOnly() bool {
+	if x != nil {
+		return x.EligibleOnly
+	}
+	return false
+}
+
+func (x *ListGroupsRequest) GetStartAfterSec() int64 {
+	if x != nil {
+		return x.StartAfterSec
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetStartBeforeSec() int64 {
+	if x != nil {
+		return x.StartBeforeSec
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetSort() GroupSort {
+	if x != nil {
+		return x.Sort
+	}
+	return GroupSort_GROUP_SORT_UPDATED
+}
+
+func (x *ListGroupsRequest) GetOpenRole() Role {
+	if x != nil {
+		return x.OpenRole
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetOpenBoon() Boon {
+	if x != nil {
+		return x.OpenBoon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountName      string          `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	GroupId          string          `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	KillProof        *KillProof      `protobuf:"bytes,4,opt,name=kill_proof,json=killProof,proto3" json:"kill_proof,omitempty"`
+	CreatedAtSec     int64           `protobuf:"varint,5,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	UpdatedAtSec     int64           `protobuf:"varint,6,opt,name=updated_at_sec,json=updatedAtSec,proto3" json:"updated_at_sec,omitempty"`
+	ApplicantProfile *AccountProfile `protobuf:"bytes,7,opt,name=applicant_profile,json=applicantProfile,proto3" json:"applicant_profile,omitempty"`
+	Role             Role            `protobuf:"varint,8,opt,name=role,proto3,enum=gw2lfg.Role" json:"role,omitempty"`
+	// The boon the applicant brings as ROLE_HEAL or ROLE_BOON_DPS.
+	Boon       Boon       `protobuf:"varint,9,opt,name=boon,proto3,enum=gw2lfg.Boon" json:"boon,omitempty"`
+	Profession Profession `protobuf:"varint,10,opt,name=profession,proto3,enum=gw2lfg.Profession" json:"profession,omitempty"`
+	// Unset for the core specialization.
+	EliteSpec EliteSpec         `protobuf:"varint,11,opt,name=elite_spec,json=eliteSpec,proto3,enum=gw2lfg.EliteSpec" json:"elite_spec,omitempty"`
+	Note      string            `protobuf:"bytes,12,opt,name=note,proto3" json:"note,omitempty"`
+	Status    ApplicationStatus `protobuf:"varint,13,opt,name=status,proto3,enum=gw2lfg.ApplicationStatus" json:"status,omitempty"`
+	// The slot an accepted application fills.
+	SlotId string `protobuf:"bytes,14,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+}
+
+func (x *GroupApplication) Reset() {
+	*x = GroupApplication{}
+	mi := &file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupApplication) ProtoMessage() {}
+
+func (x *GroupApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupApplication.ProtoReflect.Descriptor instead.
+func (*GroupApplication) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GroupApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupApplication) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GroupApplication) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupApplication) GetKillProof() *KillProof {
+	if x != nil {
+		return x.KillProof
+	}
+	return nil
+}
+
+func (x *GroupApplication) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
+	}
+	return 0
+}
+
+func (x *GroupApplication) GetUpdatedAtSec() int64 {
+	if x != nil {
+		return x.UpdatedAtSec
+	}
+	return 0
+}
+
+func (x *GroupApplication) GetApplicantProfile() *AccountProfile {
+	if x != nil {
+		return x.ApplicantProfile
+	}
+	return nil
+}
+
+func (x *GroupApplication) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *GroupApplication) GetBoon() Boon {
+	if x != nil {
+		return x.Boon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+func (x *GroupApplication) GetProfession() Profession {
+	if x != nil {
+		return x.Profession
+	}
+	return Profession_PROFESSION_UNSPECIFIED
+}
+
+func (x *GroupApplication) GetEliteSpec() EliteSpec {
+	if x != nil {
+		return x.EliteSpec
+	}
+	return EliteSpec_ELITE_SPEC_UNSPECIFIED
+}
+
+func (x *GroupApplication) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GroupApplication) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_PENDING
+}
+
+func (x *GroupApplication) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+// AccountProfile is the public part of an account's GW2 API profile.
+type AccountProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountCreatedAtSec int64 `protobuf:"varint,1,opt,name=account_created_at_sec,json=accountCreatedAtSec,proto3" json:"account_created_at_sec,omitempty"`
+	// Time played on the account.
+	AgeSec    int64 `protobuf:"varint,2,opt,name=age_sec,json=ageSec,proto3" json:"age_sec,omitempty"`
+	Commander bool  `protobuf:"varint,3,opt,name=commander,proto3" json:"commander,omitempty"`
+	// Only known if the API key has the progression permission.
+	FractalLevel uint32 `protobuf:"varint,4,opt,name=fractal_level,json=fractalLevel,proto3" json:"fractal_level,omitempty"`
+	// Owned game access, e.g. "HeartOfThorns" or "EndOfDragons".
+	Access []string `protobuf:"bytes,5,rep,name=access,proto3" json:"access,omitempty"`
+}
+
+func (x *AccountProfile) Reset() {
+	*x = AccountProfile{}
+	mi := &file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountProfile) ProtoMessage() {}
+
+func (x *AccountProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountProfile.ProtoReflect.Descriptor instead.
+func (*AccountProfile) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AccountProfile) GetAccountCreatedAtSec() int64 {
+	if x != nil {
+		return x.AccountCreatedAtSec
+	}
+	return 0
+}
+
+func (x *AccountProfile) GetAgeSec() int64 {
+	if x != nil {
+		return x.AgeSec
+	}
+	return 0
+}
+
+func (x *AccountProfile) GetCommander() bool {
+	if x != nil {
+		return x.Commander
+	}
+	return false
+}
+
+func (x *AccountProfile) GetFractalLevel() uint32 {
+	if x != nil {
+		return x.FractalLevel
+	}
+	return 0
+}
+
+func (x *AccountProfile) GetAccess() []string {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+type KillProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Li   int32 `protobuf:"varint,1,opt,name=li,proto3" json:"li,omitempty"`
+	Bskp int32 `protobuf:"varint,2,opt,name=bskp,proto3" json:"bskp,omitempty"`
+	Ufe  int32 `protobuf:"varint,3,opt,name=ufe,proto3" json:"ufe,omitempty"`
+	W1   int32 `protobuf:"varint,4,opt,name=w1,proto3" json:"w1,omitempty"`
+	W2   int32 `protobuf:"varint,5,opt,name=w2,proto3" json:"w2,omitempty"`
+	W3   int32 `protobuf:"varint,6,opt,name=w3,proto3" json:"w3,omitempty"`
+	W4   int32 `protobuf:"varint,7,opt,name=w4,proto3" json:"w4,omitempty"`
+	W5   int32 `protobuf:"varint,8,opt,name=w5,proto3" json:"w5,omitempty"`
+	W6   int32 `protobuf:"varint,9,opt,name=w6,proto3" json:"w6,omitempty"`
+	W7   int32 `protobuf:"varint,10,opt,name=w7,proto3" json:"w7,omitempty"`
+	W8   int32 `protobuf:"varint,11,opt,name=w8,proto3" json:"w8,omitempty"`
+}
+
+func (x *KillProof) Reset() {
+	*x = KillProof{}
+	mi := &file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillProof) ProtoMessage() {}
+
+func (x *KillProof) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillProof.ProtoReflect.Descriptor instead.
+func (*KillProof) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *KillProof) GetLi() int32 {
+	if x != nil {
+		return x.Li
+	}
+	return 0
+}
+
+func (x *KillProof) GetBskp() int32 {
+	if x != nil {
+		return x.Bskp
+	}
+	return 0
+}
+
+func (x *KillProof) GetUfe() int32 {
+	if x != nil {
+		return x.Ufe
+	}
+	return 0
+}
+
+func (x *KillProof) GetW1() int32 {
+	if x != nil {
+		return x.W1
+	}
+	return 0
+}
+
+func (x *KillProof) GetW2() int32 {
+	if x != nil {
+		return x.W2
+	}
+	return 0
+}
+
+func (x *KillProof) GetW3() int32 {
+	if x != nil {
+		return x.W3
+	}
+	return 0
+}
+
+func (x *KillProof) GetW4() int32 {
+	if x != nil {
+		return x.W4
+	}
+	return 0
+}
+
+func (x *KillProof) GetW5() int32 {
+	if x != nil {
+		return x.W5
+	}
+	return 0
+}
+
+func (x *KillProof) GetW6() int32 {
+	if x != nil {
+		return x.W6
+	}
+	return 0
+}
+
+func (x *KillProof) GetW7() int32 {
+	if x != nil {
+		return x.W7
+	}
+	return 0
+}
+
+func (x *KillProof) GetW8() int32 {
+	if x != nil {
+		return x.W8
+	}
+	return 0
+}
+
+type CreateGroupApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Required to apply to VISIBILITY_UNLISTED groups.
+	InviteCode string     `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	Role       Role       `protobuf:"varint,3,opt,name=role,proto3,enum=gw2lfg.Role" json:"role,omitempty"`
+	Boon       Boon       `protobuf:"varint,4,opt,name=boon,proto3,enum=gw2lfg.Boon" json:"boon,omitempty"`
+	Profession Profession `protobuf:"varint,5,opt,name=profession,proto3,enum=gw2lfg.Profession" json:"profession,omitempty"`
+	EliteSpec  EliteSpec  `protobuf:"varint,6,opt,name=elite_spec,json=eliteSpec,proto3,enum=gw2lfg.EliteSpec" json:"elite_spec,omitempty"`
+	Note       string     `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *CreateGroupApplicationRequest) Reset() {
+	*x = CreateGroupApplicationRequest{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupApplicationRequest) ProtoMessage() {}
+
+func (x *CreateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateGroupApplicationRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupApplicationRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *CreateGroupApplicationRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *CreateGroupApplicationRequest) GetBoon() Boon {
+	if x != nil {
+		return x.Boon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+func (x *CreateGroupApplicationRequest) GetProfession() Profession {
+	if x != nil {
+		return x.Profession
+	}
+	return Profession_PROFESSION_UNSPECIFIED
+}
+
+func (x *CreateGroupApplicationRequest) GetEliteSpec() EliteSpec {
+	if x != nil {
+		return x.EliteSpec
+	}
+	return EliteSpec_ELITE_SPEC_UNSPECIFIED
+}
+
+func (x *CreateGroupApplicationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateGroupApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *CreateGroupApplicationResponse) Reset() {
+	*x = CreateGroupApplicationResponse{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupApplicationResponse) ProtoMessage() {}
+
+func (x *CreateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateGroupApplicationResponse) GetApplication() *GroupApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// UpdateGroupApplicationRequest replaces what the applicant brings. The
+// application keeps its place in the queue.
+type UpdateGroupApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string     `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Role          Role       `protobuf:"varint,2,opt,name=role,proto3,enum=gw2lfg.Role" json:"role,omitempty"`
+	Boon          Boon       `protobuf:"varint,3,opt,name=boon,proto3,enum=gw2lfg.Boon" json:"boon,omitempty"`
+	Profession    Profession `protobuf:"varint,4,opt,name=profession,proto3,enum=gw2lfg.Profession" json:"profession,omitempty"`
+	EliteSpec     EliteSpec  `protobuf:"varint,5,opt,name=elite_spec,json=eliteSpec,proto3,enum=gw2lfg.EliteSpec" json:"elite_spec,omitempty"`
+	Note          string     `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *UpdateGroupApplicationRequest) Reset() {
+	*x = UpdateGroupApplicationRequest{}
+	mi := &file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupApplicationRequest) ProtoMessage() {}
+
+func (x *UpdateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateGroupApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *UpdateGroupApplicationRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateGroupApplicationRequest) GetBoon() Boon {
+	if x != nil {
+		return x.Boon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+func (x *UpdateGroupApplicationRequest) GetProfession() Profession {
+	if x != nil {
+		return x.Profession
+	}
+	return Profession_PROFESSION_UNSPECIFIED
+}
+
+func (x *UpdateGroupApplicationRequest) GetEliteSpec() EliteSpec {
+	if x != nil {
+		return x.EliteSpec
+	}
+	return EliteSpec_ELITE_SPEC_UNSPECIFIED
+}
+
+func (x *UpdateGroupApplicationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateGroupApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *UpdateGroupApplicationResponse) Reset() {
+	*x = UpdateGroupApplicationResponse{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupApplicationResponse) ProtoMessage() {}
+
+func (x *UpdateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupApplicationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateGroupApplicationResponse) GetApplication() *GroupApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type ListGroupApplicationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Id:
+	//
+	//	*ListGroupApplicationsRequest_GroupId
+	//	*ListGroupApplicationsRequest_AccountName
+	Id isListGroupApplicationsRequest_Id `protobuf_oneof:"id"`
+}
+
+func (x *ListGroupApplicationsRequest) Reset() {
+	*x = ListGroupApplicationsRequest{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupApplicationsRequest) ProtoMessage() {}
+
+func (x *ListGroupApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *ListGroupApplicationsRequest) GetId() isListGroupApplicationsRequest_Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (x *ListGroupApplicationsRequest) GetGroupId() string {
+	if x, ok := x.GetId().(*ListGroupApplicationsRequest_GroupId); ok {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListGroupApplicationsRequest) GetAccountName() string {
+	if x, ok := x.GetId().(*ListGroupApplicationsRequest_AccountName); ok {
+		return x.AccountName
+	}
+	return ""
+}
+
+type isListGroupApplicationsRequest_Id interface {
+	isListGroupApplicationsRequest_Id()
+}
+
+type ListGroupApplicationsRequest_GroupId struct {
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3,oneof"`
+}
+
+type ListGroupApplicationsRequest_AccountName struct {
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3,oneof"`
+}
+
+func (*ListGroupApplicationsRequest_GroupId) isListGroupApplicationsRequest_Id() {}
+
+func (*ListGroupApplicationsRequest_AccountName) isListGroupApplicationsRequest_Id() {}
+
+type ListGroupApplicationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applications []*GroupApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+}
+
+func (x *ListGroupApplicationsResponse) Reset() {
+	*x = ListGroupApplicationsResponse{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupApplicationsResponse) ProtoMessage() {}
+
+func (x *ListGroupApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListGroupApplicationsResponse) GetApplications() []*GroupApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type DeleteGroupApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (x *DeleteGroupApplicationRequest) Reset() {
+	*x = DeleteGroupApplicationRequest{}
+	mi := &file_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupApplicationRequest) ProtoMessage() {}
+
+func (x *DeleteGroupApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteGroupApplicationRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteGroupApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type DeleteGroupApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteGroupApplicationResponse) Reset() {
+	*x = DeleteGroupApplicationResponse{}
+	mi := &file_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupApplicationResponse) ProtoMessage() {}
+
+func (x *DeleteGroupApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupApplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{25}
+}
+
+// TODO: We need updates similar to GroupsUpdate
+type SubscribeGroupApplicationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *SubscribeGroupApplicationsRequest) Reset() {
+	*x = SubscribeGroupApplicationsRequest{}
+	mi := &file_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGroupApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGroupApplicationsRequest) ProtoMessage() {}
+
+func (x *SubscribeGroupApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGroupApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGroupApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubscribeGroupApplicationsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GroupApplicationUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//
+	//	*GroupApplicationUpdate_NewApplication
+	//	*GroupApplicationUpdate_UpdatedApplication
+	//	*GroupApplicationUpdate_RemovedApplicationId
+	//	*GroupApplicationUpdate_NewInvitation
+	//	*GroupApplicationUpdate_RemovedInvitationId
+	Update isGroupApplicationUpdate_Update `protobuf_oneof:"update"`
+}
+
+func (x *GroupApplicationUpdate) Reset() {
+	*x = GroupApplicationUpdate{}
+	mi := &file_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupApplicationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupApplicationUpdate) ProtoMessage() {}
+
+func (x *GroupApplicationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupApplicationUpdate.ProtoReflect.Descriptor instead.
+func (*GroupApplicationUpdate) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (m *GroupApplicationUpdate) GetUpdate() isGroupApplicationUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *GroupApplicationUpdate) GetNewApplication() *GroupApplication {
+	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_NewApplication); ok {
+		return x.NewApplication
+	}
+	return nil
+}
+
+func (x *GroupApplicationUpdate) GetUpdatedApplication() *GroupApplication {
+	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_UpdatedApplication); ok {
+		return x.UpdatedApplication
+	}
+	return nil
+}
+
+func (x *GroupApplicationUpdate) GetRemovedApplicationId() string {
+	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_RemovedApplicationId); ok {
+		return x.RemovedApplicationId
+	}
+	return ""
+}
+
+func (x *GroupApplicationUpdate) GetNewInvitation() *Invitation {
+	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_NewInvitation); ok {
+		return x.NewInvitation
+	}
+	return nil
+}
+
+func (x *GroupApplicationUpdate) GetRemovedInvitationId() string {
+	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_RemovedInvitationId); ok {
+		return x.RemovedInvitationId
+	}
+	return ""
+}
+
+type isGroupApplicationUpdate_Update interface {
+	isGroupApplicationUpdate_Update()
+}
+
+type GroupApplicationUpdate_NewApplication struct {
+	NewApplication *GroupApplication `protobuf:"bytes,1,opt,name=new_application,json=newApplication,proto3,oneof"`
+}
+
+type GroupApplicationUpdate_UpdatedApplication struct {
+	UpdatedApplication *GroupApplication `protobuf:"bytes,2,opt,name=updated_application,json=updatedApplication,proto3,oneof"`
+}
+
+type GroupApplicationUpdate_RemovedApplicationId struct {
+	RemovedApplicationId string `protobuf:"bytes,3,opt,name=removed_application_id,json=removedApplicationId,proto3,oneof"`
+}
+
+type GroupApplicationUpdate_NewInvitation struct {
+	// Sent to the commander and the invited account.
+	NewInvitation *Invitation `protobuf:"bytes,4,opt,name=new_invitation,json=newInvitation,proto3,oneof"`
+}
+
+type GroupApplicationUpdate_RemovedInvitationId struct {
+	RemovedInvitationId string `protobuf:"bytes,5,opt,name=removed_invitation_id,json=removedInvitationId,proto3,oneof"`
+}
+
+func (*GroupApplicationUpdate_NewApplication) isGroupApplicationUpdate_Update() {}
+
+func (*GroupApplicationUpdate_UpdatedApplication) isGroupApplicationUpdate_Update() {}
+
+func (*GroupApplicationUpdate_RemovedApplicationId) isGroupApplicationUpdate_Update() {}
+
+func (*GroupApplicationUpdate_NewInvitation) isGroupApplicationUpdate_Update() {}
+
+func (*GroupApplicationUpdate_RemovedInvitationId) isGroupApplicationUpdate_Update() {}
+
+// Invitation lets a commander ask an account to join their group.
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId      string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Commander    string `protobuf:"bytes,3,opt,name=commander,proto3" json:"commander,omitempty"`
+	AccountName  string `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CreatedAtSec int64  `protobuf:"varint,5,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	ExpiresAtSec int64  `protobuf:"varint,6,opt,name=expires_at_sec,json=expiresAtSec,proto3" json:"expires_at_sec,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Invitation) GetCommander() string {
+	if x != nil {
+		return x.Commander
+	}
+	return ""
+}
+
+func (x *Invitation) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
+	}
+	return 0
+}
+
+func (x *Invitation) GetExpiresAtSec() int64 {
+	if x != nil {
+		return x.ExpiresAtSec
+	}
+	return 0
+}
+
+type InviteToGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId     string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *InviteToGroupRequest) Reset() {
+	*x = InviteToGroupRequest{}
+	mi := &file_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGroupRequest) ProtoMessage() {}
+
+func (x *InviteToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGroupRequest.ProtoReflect.Descriptor instead.
+func (*InviteToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InviteToGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *InviteToGroupRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type InviteToGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *InviteToGroupResponse) Reset() {
+	*x = InviteToGroupResponse{}
+	mi := &file_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGroupResponse) ProtoMessage() {}
+
+func (x *InviteToGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGroupResponse.ProtoReflect.Descriptor instead.
+func (*InviteToGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InviteToGroupResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// RespondToInvitationRequest accepts or declines an invitation. Accepting
+// creates an accepted application with what the invitee brings.
+type RespondToInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string     `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Accept       bool       `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	Role         Role       `protobuf:"varint,3,opt,name=role,proto3,enum=gw2lfg.Role" json:"role,omitempty"`
+	Boon         Boon       `protobuf:"varint,4,opt,name=boon,proto3,enum=gw2lfg.Boon" json:"boon,omitempty"`
+	Profession   Profession `protobuf:"varint,5,opt,name=profession,proto3,enum=gw2lfg.Profession" json:"profession,omitempty"`
+	EliteSpec    EliteSpec  `protobuf:"varint,6,opt,name=elite_spec,json=eliteSpec,proto3,enum=gw2lfg.EliteSpec" json:"elite_spec,omitempty"`
+	Note         string     `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *RespondToInvitationRequest) Reset() {
+	*x = RespondToInvitationRequest{}
+	mi := &file_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToInvitationRequest) ProtoMessage() {}
+
+func (x *RespondToInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondToInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RespondToInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *RespondToInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *RespondToInvitationRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *RespondToInvitationRequest) GetBoon() Boon {
+	if x != nil {
+		return x.Boon
+	}
+	return Boon_BOON_UNSPECIFIED
+}
+
+func (x *RespondToInvitationRequest) GetProfession() Profession {
+	if x != nil {
+		return x.Profession
+	}
+	return Profession_PROFESSION_UNSPECIFIED
+}
+
+func (x *RespondToInvitationRequest) GetEliteSpec() EliteSpec {
+	if x != nil {
+		return x.EliteSpec
+	}
+	return EliteSpec_ELITE_SPEC_UNSPECIFIED
+}
+
+func (x *RespondToInvitationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RespondToInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set if the invitation was accepted.
+	Application *GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *RespondToInvitationResponse) Reset() {
+	*x = RespondToInvitationResponse{}
+	mi := &file_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToInvitationResponse) ProtoMessage() {}
+
+func (x *RespondToInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RespondToInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RespondToInvitationResponse) GetApplication() *GroupApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type CancelInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+}
+
+func (x *CancelInvitationRequest) Reset() {
+	*x = CancelInvitationRequest{}
+	mi := &file_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationRequest) ProtoMessage() {}
+
+func (x *CancelInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type CancelInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelInvitationResponse) Reset() {
+	*x = CancelInvitationResponse{}
+	mi := &file_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationResponse) ProtoMessage() {}
+
+func (x *CancelInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CancelInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34}
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Invitations to the caller's group, or to the caller if unset.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListInvitationsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// ReviewGroupApplicationRequest is how a commander accepts or declines an
+// application, or puts it back to pending.
+type ReviewGroupApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string            `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Status        ApplicationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=gw2lfg.ApplicationStatus" json:"status,omitempty"`
+	// The slot to accept the application into. If empty, the first open slot
+	// matching the applicant's role is used.
+	SlotId string `protobuf:"bytes,3,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+}
+
+func (x *ReviewGroupApplicationRequest) Reset() {
+	*x = ReviewGroupApplicationRequest{}
+	mi := &file_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewGroupApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGroupApplicationRequest) ProtoMessage() {}
+
+func (x *ReviewGroupApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGroupApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewGroupApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReviewGroupApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ReviewGroupApplicationRequest) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_PENDING
+}
+
+func (x *ReviewGroupApplicationRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+type ReviewGroupApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *ReviewGroupApplicationResponse) Reset() {
+	*x = ReviewGroupApplicationResponse{}
+	mi := &file_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewGroupApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGroupApplicationResponse) ProtoMessage() {}
+
+func (x *ReviewGroupApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGroupApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewGroupApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewGroupApplicationResponse) GetApplication() *GroupApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{39}
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{40}
+}
+
+// GroupTemplate stores group settings to reuse. With a recurrence, the
+// server creates a group from it ahead of every occurrence.
+type GroupTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Settings of the created groups. The start time is ignored.
+	Group      *CreateGroupRequest `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Recurrence *Recurrence         `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// How long before each occurrence the group is created.
+	LeadTimeSec  int64 `protobuf:"varint,6,opt,name=lead_time_sec,json=leadTimeSec,proto3" json:"lead_time_sec,omitempty"`
+	CreatedAtSec int64 `protobuf:"varint,7,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	UpdatedAtSec int64 `protobuf:"varint,8,opt,name=updated_at_sec,json=updatedAtSec,proto3" json:"updated_at_sec,omitempty"`
+	// Start of the most recent group created from the template.
+	LastStartAtSec int64 `protobuf:"varint,9,opt,name=last_start_at_sec,json=lastStartAtSec,proto3" json:"last_start_at_sec,omitempty"`
+}
+
+func (x *GroupTemplate) Reset() {
+	*x = GroupTemplate{}
+	mi := &file_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTemplate) ProtoMessage() {}
+
+func (x *GroupTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTemplate.ProtoReflect.Descriptor instead.
+func (*GroupTemplate) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GroupTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupTemplate) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GroupTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupTemplate) GetGroup() *CreateGroupRequest {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupTemplate) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *GroupTemplate) GetLeadTimeSec() int64 {
+	if x != nil {
+		return x.LeadTimeSec
+	}
+	return 0
+}
+
+func (x *GroupTemplate) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
+	}
+	return 0
+}
+
+func (x *GroupTemplate) GetUpdatedAtSec() int64 {
+	if x != nil {
+		return x.UpdatedAtSec
+	}
+	return 0
+}
+
+func (x *GroupTemplate) GetLastStartAtSec() int64 {
+	if x != nil {
+		return x.LastStartAtSec
+	}
+	return 0
+}
+
+// Recurrence repeats weekly on the given days at the same local time.
+type Recurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days []Weekday `protobuf:"varint,1,rep,packed,name=days,proto3,enum=gw2lfg.Weekday" json:"days,omitempty"`
+	// Minutes after local midnight.
+	StartMinute uint32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	// IANA time zone name, e.g. "Europe/Berlin".
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Recurrence) GetDays() []Weekday {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Recurrence) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *Recurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type CreateGroupTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *GroupTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateGroupTemplateRequest) Reset() {
+	*x = CreateGroupTemplateRequest{}
+	mi := &file_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupTemplateRequest) ProtoMessage() {}
+
+func (x *CreateGroupTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateGroupTemplateRequest) GetTemplate() *GroupTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateGroupTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *GroupTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateGroupTemplateResponse) Reset() {
+	*x = CreateGroupTemplateResponse{}
+	mi := &file_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupTemplateResponse) ProtoMessage() {}
+
+func (x *CreateGroupTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateGroupTemplateResponse) GetTemplate() *GroupTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateGroupTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *GroupTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateGroupTemplateRequest) Reset() {
+	*x = UpdateGroupTemplateRequest{}
+	mi := &file_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateGroupTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateGroupTemplateRequest) GetTemplate() *GroupTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateGroupTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *GroupTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateGroupTemplateResponse) Reset() {
+	*x = UpdateGroupTemplateResponse{}
+	mi := &file_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateGroupTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateGroupTemplateResponse) GetTemplate() *GroupTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteGroupTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+}
+
+func (x *DeleteGroupTemplateRequest) Reset() {
+	*x = DeleteGroupTemplateRequest{}
+	mi := &file_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteGroupTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteGroupTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteGroupTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteGroupTemplateResponse) Reset() {
+	*x = DeleteGroupTemplateResponse{}
+	mi := &file_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteGroupTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{48}
+}
+
+type ListGroupTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGroupTemplatesRequest) Reset() {
+	*x = ListGroupTemplatesRequest{}
+	mi := &file_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupTemplatesRequest) ProtoMessage() {}
+
+func (x *ListGroupTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{49}
+}
+
+type ListGroupTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*GroupTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListGroupTemplatesResponse) Reset() {
+	*x = ListGroupTemplatesResponse{}
+	mi := &file_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupTemplatesResponse) ProtoMessage() {}
+
+func (x *ListGroupTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListGroupTemplatesResponse) GetTemplates() []*GroupTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Replaces the feed secret, so the previous URL stops working.
+	Reset_ bool `protobuf:"varint,1,opt,name=reset,proto3" json:"reset,omitempty"`
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetCalendarFeedRequest) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+type GetCalendarFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the account's feed on the server, containing its secret.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetCalendarFeedResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BlockAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *BlockAccountRequest) Reset() {
+	*x = BlockAccountRequest{}
+	mi := &file_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAccountRequest) ProtoMessage() {}
+
+func (x *BlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*BlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BlockAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type BlockAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockAccountResponse) Reset() {
+	*x = BlockAccountResponse{}
+	mi := &file_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAccountResponse) ProtoMessage() {}
+
+func (x *BlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*BlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{54}
+}
+
+type UnblockAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *UnblockAccountRequest) Reset() {
+	*x = UnblockAccountRequest{}
+	mi := &file_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockAccountRequest) ProtoMessage() {}
+
+func (x *UnblockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnblockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnblockAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type UnblockAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockAccountResponse) Reset() {
+	*x = UnblockAccountResponse{}
+	mi := &file_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockAccountResponse) ProtoMessage() {}
+
+func (x *UnblockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnblockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{56}
+}
+
+type ListBlockedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBlockedRequest) Reset() {
+	*x = ListBlockedRequest{}
+	mi := &file_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedRequest) ProtoMessage() {}
+
+func (x *ListBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{57}
+}
+
+type ListBlockedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocked []*BlockedAccount `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *ListBlockedResponse) Reset() {
+	*x = ListBlockedResponse{}
+	mi := &file_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedResponse) ProtoMessage() {}
+
+func (x *ListBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListBlockedResponse) GetBlocked() []*BlockedAccount {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+// BlockedAccount can't apply to any group of the commander that blocked it.
+type BlockedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName  string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CreatedAtSec int64  `protobuf:"varint,2,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+}
+
+func (x *BlockedAccount) Reset() {
+	*x = BlockedAccount{}
+	mi := &file_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedAccount) ProtoMessage() {}
+
+func (x *BlockedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedAccount.ProtoReflect.Descriptor instead.
+func (*BlockedAccount) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BlockedAccount) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BlockedAccount) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
+	}
+	return 0
+}
+
+type WhitelistAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *WhitelistAccountRequest) Reset() {
+	*x = WhitelistAccountRequest{}
+	mi := &file_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhitelistAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistAccountRequest) ProtoMessage() {}
+
+func (x *WhitelistAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistAccountRequest.ProtoReflect.Descriptor instead.
+func (*WhitelistAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WhitelistAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type WhitelistAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WhitelistAccountResponse) Reset() {
+	*x = WhitelistAccountResponse{}
+	mi := &file_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhitelistAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistAccountResponse) ProtoMessage() {}
+
+func (x *WhitelistAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistAccountResponse.ProtoReflect.Descriptor instead.
+func (*WhitelistAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{61}
+}
+
+type UnwhitelistAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *UnwhitelistAccountRequest) Reset() {
+	*x = UnwhitelistAccountRequest{}
+	mi := &file_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-// Enum value maps for KillProofId.
-var (
-	KillProofId_name = map[int32]string{
-		0: "KP_UNKNOWN",
-		1: "KP_LI",
-		2: "KP_BSKP",
-		3: "KP_UFE",
+func (x *UnwhitelistAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwhitelistAccountRequest) ProtoMessage() {}
+
+func (x *UnwhitelistAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	KillProofId_value = map[string]int32{
-		"KP_UNKNOWN": 0,
-		"KP_LI":      1,
-		"KP_BSKP":    2,
-		"KP_UFE":     3,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwhitelistAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnwhitelistAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UnwhitelistAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
 	}
-)
+	return ""
+}
 
-func (x KillProofId) Enum() *KillProofId {
-	p := new(KillProofId)
-	*p = x
-	return p
+type UnwhitelistAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x KillProofId) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *UnwhitelistAccountResponse) Reset() {
+	*x = UnwhitelistAccountResponse{}
+	mi := &file_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (KillProofId) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[1].Descriptor()
+func (x *UnwhitelistAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (KillProofId) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[1]
+func (*UnwhitelistAccountResponse) ProtoMessage() {}
+
+func (x *UnwhitelistAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x KillProofId) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use UnwhitelistAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnwhitelistAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{63}
 }
 
-// Deprecated: Use KillProofId.Descriptor instead.
-func (KillProofId) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{1}
+type ListWhitelistedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type CreateGroupRequest struct {
+func (x *ListWhitelistedRequest) Reset() {
+	*x = ListWhitelistedRequest{}
+	mi := &file_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWhitelistedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWhitelistedRequest) ProtoMessage() {}
+
+func (x *ListWhitelistedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWhitelistedRequest.ProtoReflect.Descriptor instead.
+func (*ListWhitelistedRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{64}
+}
+
+type ListWhitelistedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title            string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	KillProofId      KillProofId `protobuf:"varint,2,opt,name=kill_proof_id,json=killProofId,proto3,enum=gw2lfg.KillProofId" json:"kill_proof_id,omitempty"`
-	KillProofMinimum uint32      `protobuf:"varint,3,opt,name=kill_proof_minimum,json=killProofMinimum,proto3" json:"kill_proof_minimum,omitempty"`
+	Whitelisted []*WhitelistedAccount `protobuf:"bytes,1,rep,name=whitelisted,proto3" json:"whitelisted,omitempty"`
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_service_proto_msgTypes[0]
+func (x *ListWhitelistedResponse) Reset() {
+	*x = ListWhitelistedResponse{}
+	mi := &file_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *ListWhitelistedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*ListWhitelistedResponse) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[0]
+func (x *ListWhitelistedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,55 +4690,99 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListWhitelistedResponse.ProtoReflect.Descriptor instead.
+func (*ListWhitelistedResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreateGroupRequest) GetTitle() string {
+func (x *ListWhitelistedResponse) GetWhitelisted() []*WhitelistedAccount {
 	if x != nil {
-		return x.Title
+		return x.Whitelisted
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGroupRequest) GetKillProofId() KillProofId {
+// WhitelistedAccount is accepted right away by groups of the commander that
+// whitelisted it using AUTO_ACCEPT_WHITELIST.
+type WhitelistedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName  string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CreatedAtSec int64  `protobuf:"varint,2,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+}
+
+func (x *WhitelistedAccount) Reset() {
+	*x = WhitelistedAccount{}
+	mi := &file_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhitelistedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistedAccount) ProtoMessage() {}
+
+func (x *WhitelistedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[66]
 	if x != nil {
-		return x.KillProofId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return KillProofId_KP_UNKNOWN
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGroupRequest) GetKillProofMinimum() uint32 {
+// Deprecated: Use WhitelistedAccount.ProtoReflect.Descriptor instead.
+func (*WhitelistedAccount) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WhitelistedAccount) GetAccountName() string {
 	if x != nil {
-		return x.KillProofMinimum
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *WhitelistedAccount) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
 	}
 	return 0
 }
 
-type CreateGroupResponse struct {
+type ReportGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Needed to report VISIBILITY_UNLISTED groups.
+	InviteCode string `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 }
 
-func (x *CreateGroupResponse) Reset() {
-	*x = CreateGroupResponse{}
-	mi := &file_service_proto_msgTypes[1]
+func (x *ReportGroupRequest) Reset() {
+	*x = ReportGroupRequest{}
+	mi := &file_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupResponse) String() string {
+func (x *ReportGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupResponse) ProtoMessage() {}
+func (*ReportGroupRequest) ProtoMessage() {}
 
-func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[1]
+func (x *ReportGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,47 +4793,193 @@ func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ReportGroupRequest.ProtoReflect.Descriptor instead.
+func (*ReportGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReportGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ReportGroupRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportGroupRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type ReportGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReportGroupResponse) Reset() {
+	*x = ReportGroupResponse{}
+	mi := &file_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportGroupResponse) ProtoMessage() {}
+
+func (x *ReportGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportGroupResponse.ProtoReflect.Descriptor instead.
+func (*ReportGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{68}
+}
+
+type ReportApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReportApplicationRequest) Reset() {
+	*x = ReportApplicationRequest{}
+	mi := &file_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportApplicationRequest) ProtoMessage() {}
+
+func (x *ReportApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReportApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReportApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ReportApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReportApplicationResponse) Reset() {
+	*x = ReportApplicationResponse{}
+	mi := &file_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportApplicationResponse) ProtoMessage() {}
+
+func (x *ReportApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGroupResponse) GetGroup() *Group {
-	if x != nil {
-		return x.Group
-	}
-	return nil
+// Deprecated: Use ReportApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReportApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{70}
 }
 
-type Group struct {
+type Report struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId        string      `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Title            string      `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	KillProofId      KillProofId `protobuf:"varint,4,opt,name=kill_proof_id,json=killProofId,proto3,enum=gw2lfg.KillProofId" json:"kill_proof_id,omitempty"`
-	KillProofMinimum uint32      `protobuf:"varint,5,opt,name=kill_proof_minimum,json=killProofMinimum,proto3" json:"kill_proof_minimum,omitempty"`
-	CreatedAtSec     int64       `protobuf:"varint,6,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
-	UpdatedAtSec     int64       `protobuf:"varint,7,opt,name=updated_at_sec,json=updatedAtSec,proto3" json:"updated_at_sec,omitempty"`
-}
-
-func (x *Group) Reset() {
-	*x = Group{}
-	mi := &file_service_proto_msgTypes[2]
+	Id         string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reporter   string           `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	TargetType ReportTargetType `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=gw2lfg.ReportTargetType" json:"target_type,omitempty"`
+	TargetId   string           `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Creator of the reported group or application.
+	TargetAccountName string `protobuf:"bytes,5,opt,name=target_account_name,json=targetAccountName,proto3" json:"target_account_name,omitempty"`
+	Reason            string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// JSON of the reported content at the time of the report.
+	Snapshot      string `protobuf:"bytes,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	CreatedAtSec  int64  `protobuf:"varint,8,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	Resolved      bool   `protobuf:"varint,9,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedBy    string `protobuf:"bytes,10,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	Resolution    string `protobuf:"bytes,11,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolvedAtSec int64  `protobuf:"varint,12,opt,name=resolved_at_sec,json=resolvedAtSec,proto3" json:"resolved_at_sec,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) String() string {
+func (x *Report) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Group) ProtoMessage() {}
+func (*Report) ProtoMessage() {}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[2]
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,83 +4990,119 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Group) GetId() string {
+func (x *Report) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Group) GetCreatorId() string {
+func (x *Report) GetReporter() string {
 	if x != nil {
-		return x.CreatorId
+		return x.Reporter
 	}
 	return ""
 }
 
-func (x *Group) GetTitle() string {
+func (x *Report) GetTargetType() ReportTargetType {
 	if x != nil {
-		return x.Title
+		return x.TargetType
+	}
+	return ReportTargetType_REPORT_TARGET_UNKNOWN
+}
+
+func (x *Report) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
 	}
 	return ""
 }
 
-func (x *Group) GetKillProofId() KillProofId {
+func (x *Report) GetTargetAccountName() string {
 	if x != nil {
-		return x.KillProofId
+		return x.TargetAccountName
 	}
-	return KillProofId_KP_UNKNOWN
+	return ""
 }
 
-func (x *Group) GetKillProofMinimum() uint32 {
+func (x *Report) GetReason() string {
 	if x != nil {
-		return x.KillProofMinimum
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *Group) GetCreatedAtSec() int64 {
+func (x *Report) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAtSec() int64 {
 	if x != nil {
 		return x.CreatedAtSec
 	}
 	return 0
 }
 
-func (x *Group) GetUpdatedAtSec() int64 {
+func (x *Report) GetResolved() bool {
 	if x != nil {
-		return x.UpdatedAtSec
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *Report) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Report) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *Report) GetResolvedAtSec() int64 {
+	if x != nil {
+		return x.ResolvedAtSec
 	}
 	return 0
 }
 
-type UpdateGroupRequest struct {
+type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// GW2 API key with at least the account permission.
+	ApiKey string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
-	mi := &file_service_proto_msgTypes[3]
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[3]
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,41 +5113,41 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateGroupRequest) GetGroup() *Group {
+func (x *LoginRequest) GetApiKey() string {
 	if x != nil {
-		return x.Group
+		return x.ApiKey
 	}
-	return nil
+	return ""
 }
 
-type UpdateGroupResponse struct {
+type LoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *UpdateGroupResponse) Reset() {
-	*x = UpdateGroupResponse{}
-	mi := &file_service_proto_msgTypes[4]
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupResponse) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupResponse) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[4]
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,41 +5158,41 @@ func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateGroupResponse) GetGroup() *Group {
+func (x *LoginResponse) GetSession() *Session {
 	if x != nil {
-		return x.Group
+		return x.Session
 	}
 	return nil
 }
 
-type DeleteGroupRequest struct {
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *DeleteGroupRequest) Reset() {
-	*x = DeleteGroupRequest{}
-	mi := &file_service_proto_msgTypes[5]
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupRequest) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupRequest) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[5]
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,39 +5203,41 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DeleteGroupRequest) GetGroupId() string {
+func (x *RefreshSessionRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.GroupId
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type DeleteGroupResponse struct {
+type RefreshSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *DeleteGroupResponse) Reset() {
-	*x = DeleteGroupResponse{}
-	mi := &file_service_proto_msgTypes[6]
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupResponse) String() string {
+func (x *RefreshSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupResponse) ProtoMessage() {}
+func (*RefreshSessionResponse) ProtoMessage() {}
 
-func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[6]
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,32 +5248,47 @@ func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{75}
 }
 
-type SubscribeGroupsRequest struct {
+func (x *RefreshSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// Session replaces the GW2 API key as bearer token. The session token is
+// short-lived and can be renewed with the refresh token.
+type Session struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountName         string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	SessionToken        string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	SessionExpiresAtSec int64  `protobuf:"varint,3,opt,name=session_expires_at_sec,json=sessionExpiresAtSec,proto3" json:"session_expires_at_sec,omitempty"`
+	RefreshToken        string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshExpiresAtSec int64  `protobuf:"varint,5,opt,name=refresh_expires_at_sec,json=refreshExpiresAtSec,proto3" json:"refresh_expires_at_sec,omitempty"`
 }
 
-func (x *SubscribeGroupsRequest) Reset() {
-	*x = SubscribeGroupsRequest{}
-	mi := &file_service_proto_msgTypes[7]
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeGroupsRequest) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeGroupsRequest) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *SubscribeGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[7]
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,39 +5299,75 @@ func (x *SubscribeGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeGroupsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{76}
 }
 
-type GroupsUpdate struct {
+func (x *Session) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Session) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *Session) GetSessionExpiresAtSec() int64 {
+	if x != nil {
+		return x.SessionExpiresAtSec
+	}
+	return 0
+}
+
+func (x *Session) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *Session) GetRefreshExpiresAtSec() int64 {
+	if x != nil {
+		return x.RefreshExpiresAtSec
+	}
+	return 0
+}
+
+// Ban keeps an account from using the server until it expires.
+type Ban struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Update:
-	//
-	//	*GroupsUpdate_NewGroup
-	//	*GroupsUpdate_UpdatedGroup
-	//	*GroupsUpdate_RemovedGroupId
-	Update isGroupsUpdate_Update `protobuf_oneof:"update"`
+	AccountName  string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Reason       string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedBy     string `protobuf:"bytes,3,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	CreatedAtSec int64  `protobuf:"varint,4,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
+	// Zero for permanent bans.
+	ExpiresAtSec int64 `protobuf:"varint,5,opt,name=expires_at_sec,json=expiresAtSec,proto3" json:"expires_at_sec,omitempty"`
 }
 
-func (x *GroupsUpdate) Reset() {
-	*x = GroupsUpdate{}
-	mi := &file_service_proto_msgTypes[8]
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupsUpdate) String() string {
+func (x *Ban) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupsUpdate) ProtoMessage() {}
+func (*Ban) ProtoMessage() {}
 
-func (x *GroupsUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[8]
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,82 +5378,129 @@ func (x *GroupsUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupsUpdate.ProtoReflect.Descriptor instead.
-func (*GroupsUpdate) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (m *GroupsUpdate) GetUpdate() isGroupsUpdate_Update {
-	if m != nil {
-		return m.Update
+func (x *Ban) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupsUpdate) GetNewGroup() *Group {
-	if x, ok := x.GetUpdate().(*GroupsUpdate_NewGroup); ok {
-		return x.NewGroup
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupsUpdate) GetUpdatedGroup() *Group {
-	if x, ok := x.GetUpdate().(*GroupsUpdate_UpdatedGroup); ok {
-		return x.UpdatedGroup
+func (x *Ban) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupsUpdate) GetRemovedGroupId() string {
-	if x, ok := x.GetUpdate().(*GroupsUpdate_RemovedGroupId); ok {
-		return x.RemovedGroupId
+func (x *Ban) GetCreatedAtSec() int64 {
+	if x != nil {
+		return x.CreatedAtSec
 	}
-	return ""
+	return 0
 }
 
-type isGroupsUpdate_Update interface {
-	isGroupsUpdate_Update()
+func (x *Ban) GetExpiresAtSec() int64 {
+	if x != nil {
+		return x.ExpiresAtSec
+	}
+	return 0
 }
 
-type GroupsUpdate_NewGroup struct {
-	NewGroup *Group `protobuf:"bytes,1,opt,name=new_group,json=newGroup,proto3,oneof"`
+type AdminGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group        *Group              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Applications []*GroupApplication `protobuf:"bytes,2,rep,name=applications,proto3" json:"applications,omitempty"`
+	// Set if the creator is currently banned.
+	CreatorBan *Ban `protobuf:"bytes,3,opt,name=creator_ban,json=creatorBan,proto3" json:"creator_ban,omitempty"`
 }
 
-type GroupsUpdate_UpdatedGroup struct {
-	UpdatedGroup *Group `protobuf:"bytes,2,opt,name=updated_group,json=updatedGroup,proto3,oneof"`
+func (x *AdminGroup) Reset() {
+	*x = AdminGroup{}
+	mi := &file_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GroupsUpdate_RemovedGroupId struct {
-	RemovedGroupId string `protobuf:"bytes,3,opt,name=removed_group_id,json=removedGroupId,proto3,oneof"`
+func (x *AdminGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupsUpdate_NewGroup) isGroupsUpdate_Update() {}
+func (*AdminGroup) ProtoMessage() {}
 
-func (*GroupsUpdate_UpdatedGroup) isGroupsUpdate_Update() {}
+func (x *AdminGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*GroupsUpdate_RemovedGroupId) isGroupsUpdate_Update() {}
+// Deprecated: Use AdminGroup.ProtoReflect.Descriptor instead.
+func (*AdminGroup) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdminGroup) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AdminGroup) GetApplications() []*GroupApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *AdminGroup) GetCreatorBan() *Ban {
+	if x != nil {
+		return x.CreatorBan
+	}
+	return nil
+}
 
-type ListGroupsRequest struct {
+type AdminListGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListGroupsRequest) Reset() {
-	*x = ListGroupsRequest{}
-	mi := &file_service_proto_msgTypes[9]
+func (x *AdminListGroupsRequest) Reset() {
+	*x = AdminListGroupsRequest{}
+	mi := &file_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsRequest) String() string {
+func (x *AdminListGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsRequest) ProtoMessage() {}
+func (*AdminListGroupsRequest) ProtoMessage() {}
 
-func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[9]
+func (x *AdminListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,34 +5511,34 @@ func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AdminListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{79}
 }
 
-type ListGroupsResponse struct {
+type AdminListGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Groups []*AdminGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *ListGroupsResponse) Reset() {
-	*x = ListGroupsResponse{}
-	mi := &file_service_proto_msgTypes[10]
+func (x *AdminListGroupsResponse) Reset() {
+	*x = AdminListGroupsResponse{}
+	mi := &file_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsResponse) String() string {
+func (x *AdminListGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsResponse) ProtoMessage() {}
+func (*AdminListGroupsResponse) ProtoMessage() {}
 
-func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[10]
+func (x *AdminListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,46 +5549,42 @@ func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AdminListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListGroupsResponse) GetGroups() []*Group {
+func (x *AdminListGroupsResponse) GetGroups() []*AdminGroup {
 	if x != nil {
 		return x.Groups
 	}
 	return nil
 }
 
-type GroupApplication struct {
+type ForceDeleteGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountName  string     `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	GroupId      string     `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	KillProof    *KillProof `protobuf:"bytes,4,opt,name=kill_proof,json=killProof,proto3" json:"kill_proof,omitempty"`
-	CreatedAtSec int64      `protobuf:"varint,5,opt,name=created_at_sec,json=createdAtSec,proto3" json:"created_at_sec,omitempty"`
-	UpdatedAtSec int64      `protobuf:"varint,6,opt,name=updated_at_sec,json=updatedAtSec,proto3" json:"updated_at_sec,omitempty"`
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GroupApplication) Reset() {
-	*x = GroupApplication{}
-	mi := &file_service_proto_msgTypes[11]
+func (x *ForceDeleteGroupRequest) Reset() {
+	*x = ForceDeleteGroupRequest{}
+	mi := &file_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupApplication) String() string {
+func (x *ForceDeleteGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupApplication) ProtoMessage() {}
+func (*ForceDeleteGroupRequest) ProtoMessage() {}
 
-func (x *GroupApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[11]
+func (x *ForceDeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,86 +5595,46 @@ func (x *GroupApplication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupApplication.ProtoReflect.Descriptor instead.
-func (*GroupApplication) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GroupApplication) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GroupApplication) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
+// Deprecated: Use ForceDeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GroupApplication) GetGroupId() string {
+func (x *ForceDeleteGroupRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GroupApplication) GetKillProof() *KillProof {
-	if x != nil {
-		return x.KillProof
-	}
-	return nil
-}
-
-func (x *GroupApplication) GetCreatedAtSec() int64 {
-	if x != nil {
-		return x.CreatedAtSec
-	}
-	return 0
-}
-
-func (x *GroupApplication) GetUpdatedAtSec() int64 {
+func (x *ForceDeleteGroupRequest) GetReason() string {
 	if x != nil {
-		return x.UpdatedAtSec
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type KillProof struct {
+type ForceDeleteGroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Li   int32 `protobuf:"varint,1,opt,name=li,proto3" json:"li,omitempty"`
-	Bskp int32 `protobuf:"varint,2,opt,name=bskp,proto3" json:"bskp,omitempty"`
-	Ufe  int32 `protobuf:"varint,3,opt,name=ufe,proto3" json:"ufe,omitempty"`
-	W1   int32 `protobuf:"varint,4,opt,name=w1,proto3" json:"w1,omitempty"`
-	W2   int32 `protobuf:"varint,5,opt,name=w2,proto3" json:"w2,omitempty"`
-	W3   int32 `protobuf:"varint,6,opt,name=w3,proto3" json:"w3,omitempty"`
-	W4   int32 `protobuf:"varint,7,opt,name=w4,proto3" json:"w4,omitempty"`
-	W5   int32 `protobuf:"varint,8,opt,name=w5,proto3" json:"w5,omitempty"`
-	W6   int32 `protobuf:"varint,9,opt,name=w6,proto3" json:"w6,omitempty"`
-	W7   int32 `protobuf:"varint,10,opt,name=w7,proto3" json:"w7,omitempty"`
-	W8   int32 `protobuf:"varint,11,opt,name=w8,proto3" json:"w8,omitempty"`
 }
 
-func (x *KillProof) Reset() {
-	*x = KillProof{}
-	mi := &file_service_proto_msgTypes[12]
+func (x *ForceDeleteGroupResponse) Reset() {
+	*x = ForceDeleteGroupResponse{}
+	mi := &file_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KillProof) String() string {
+func (x *ForceDeleteGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillProof) ProtoMessage() {}
+func (*ForceDeleteGroupResponse) ProtoMessage() {}
 
-func (x *KillProof) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[12]
+func (x *ForceDeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,111 +5645,85 @@ func (x *KillProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillProof.ProtoReflect.Descriptor instead.
-func (*KillProof) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *KillProof) GetLi() int32 {
-	if x != nil {
-		return x.Li
-	}
-	return 0
-}
-
-func (x *KillProof) GetBskp() int32 {
-	if x != nil {
-		return x.Bskp
-	}
-	return 0
+// Deprecated: Use ForceDeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *KillProof) GetUfe() int32 {
-	if x != nil {
-		return x.Ufe
-	}
-	return 0
-}
+type ForceDeleteApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *KillProof) GetW1() int32 {
-	if x != nil {
-		return x.W1
-	}
-	return 0
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *KillProof) GetW2() int32 {
-	if x != nil {
-		return x.W2
-	}
-	return 0
+func (x *ForceDeleteApplicationRequest) Reset() {
+	*x = ForceDeleteApplicationRequest{}
+	mi := &file_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *KillProof) GetW3() int32 {
-	if x != nil {
-		return x.W3
-	}
-	return 0
+func (x *ForceDeleteApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *KillProof) GetW4() int32 {
-	if x != nil {
-		return x.W4
-	}
-	return 0
-}
+func (*ForceDeleteApplicationRequest) ProtoMessage() {}
 
-func (x *KillProof) GetW5() int32 {
+func (x *ForceDeleteApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[83]
 	if x != nil {
-		return x.W5
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *KillProof) GetW6() int32 {
-	if x != nil {
-		return x.W6
-	}
-	return 0
+// Deprecated: Use ForceDeleteApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *KillProof) GetW7() int32 {
+func (x *ForceDeleteApplicationRequest) GetApplicationId() string {
 	if x != nil {
-		return x.W7
+		return x.ApplicationId
 	}
-	return 0
+	return ""
 }
 
-func (x *KillProof) GetW8() int32 {
+func (x *ForceDeleteApplicationRequest) GetReason() string {
 	if x != nil {
-		return x.W8
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type CreateGroupApplicationRequest struct {
+type ForceDeleteApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *CreateGroupApplicationRequest) Reset() {
-	*x = CreateGroupApplicationRequest{}
-	mi := &file_service_proto_msgTypes[13]
+func (x *ForceDeleteApplicationResponse) Reset() {
+	*x = ForceDeleteApplicationResponse{}
+	mi := &file_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupApplicationRequest) String() string {
+func (x *ForceDeleteApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupApplicationRequest) ProtoMessage() {}
+func (*ForceDeleteApplicationResponse) ProtoMessage() {}
 
-func (x *CreateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[13]
+func (x *ForceDeleteApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,41 +5734,37 @@ func (x *CreateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateGroupApplicationRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
+// Deprecated: Use ForceDeleteApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{84}
 }
 
-type CreateGroupApplicationResponse struct {
+type BanAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Application *GroupApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Zero bans the account permanently.
+	DurationSec int64 `protobuf:"varint,3,opt,name=duration_sec,json=durationSec,proto3" json:"duration_sec,omitempty"`
 }
 
-func (x *CreateGroupApplicationResponse) Reset() {
-	*x = CreateGroupApplicationResponse{}
-	mi := &file_service_proto_msgTypes[14]
+func (x *BanAccountRequest) Reset() {
+	*x = BanAccountRequest{}
+	mi := &file_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupApplicationResponse) String() string {
+func (x *BanAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupApplicationResponse) ProtoMessage() {}
+func (*BanAccountRequest) ProtoMessage() {}
 
-func (x *CreateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[14]
+func (x *BanAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,39 +5775,55 @@ func (x *CreateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupApplicationResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BanAccountRequest.ProtoReflect.Descriptor instead.
+func (*BanAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateGroupApplicationResponse) GetApplication() *GroupApplication {
+func (x *BanAccountRequest) GetAccountName() string {
 	if x != nil {
-		return x.Application
+		return x.AccountName
 	}
-	return nil
+	return ""
 }
 
-type UpdateGroupApplicationRequest struct {
+func (x *BanAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanAccountRequest) GetDurationSec() int64 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+type BanAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ban *Ban `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
 }
 
-func (x *UpdateGroupApplicationRequest) Reset() {
-	*x = UpdateGroupApplicationRequest{}
-	mi := &file_service_proto_msgTypes[15]
+func (x *BanAccountResponse) Reset() {
+	*x = BanAccountResponse{}
+	mi := &file_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupApplicationRequest) String() string {
+func (x *BanAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupApplicationRequest) ProtoMessage() {}
+func (*BanAccountResponse) ProtoMessage() {}
 
-func (x *UpdateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[15]
+func (x *BanAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,32 +5834,42 @@ func (x *UpdateGroupApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupApplicationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use BanAccountResponse.ProtoReflect.Descriptor instead.
+func (*BanAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{86}
 }
 
-type UpdateGroupApplicationResponse struct {
+func (x *BanAccountResponse) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type UnbanAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *UpdateGroupApplicationResponse) Reset() {
-	*x = UpdateGroupApplicationResponse{}
-	mi := &file_service_proto_msgTypes[16]
+func (x *UnbanAccountRequest) Reset() {
+	*x = UnbanAccountRequest{}
+	mi := &file_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupApplicationResponse) String() string {
+func (x *UnbanAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupApplicationResponse) ProtoMessage() {}
+func (*UnbanAccountRequest) ProtoMessage() {}
 
-func (x *UpdateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[16]
+func (x *UnbanAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,38 +5880,46 @@ func (x *UpdateGroupApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupApplicationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UnbanAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnbanAccountRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{87}
 }
 
-type ListGroupApplicationsRequest struct {
+func (x *UnbanAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UnbanAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnbanAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Id:
-	//
-	//	*ListGroupApplicationsRequest_GroupId
-	//	*ListGroupApplicationsRequest_AccountName
-	Id isListGroupApplicationsRequest_Id `protobuf_oneof:"id"`
 }
 
-func (x *ListGroupApplicationsRequest) Reset() {
-	*x = ListGroupApplicationsRequest{}
-	mi := &file_service_proto_msgTypes[17]
+func (x *UnbanAccountResponse) Reset() {
+	*x = UnbanAccountResponse{}
+	mi := &file_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupApplicationsRequest) String() string {
+func (x *UnbanAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupApplicationsRequest) ProtoMessage() {}
+func (*UnbanAccountResponse) ProtoMessage() {}
 
-func (x *ListGroupApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[17]
+func (x *UnbanAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,71 +5930,70 @@ func (x *ListGroupApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UnbanAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnbanAccountResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (m *ListGroupApplicationsRequest) GetId() isListGroupApplicationsRequest_Id {
-	if m != nil {
-		return m.Id
-	}
-	return nil
+type ListBansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListGroupApplicationsRequest) GetGroupId() string {
-	if x, ok := x.GetId().(*ListGroupApplicationsRequest_GroupId); ok {
-		return x.GroupId
-	}
-	return ""
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	mi := &file_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupApplicationsRequest) GetAccountName() string {
-	if x, ok := x.GetId().(*ListGroupApplicationsRequest_AccountName); ok {
-		return x.AccountName
-	}
-	return ""
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isListGroupApplicationsRequest_Id interface {
-	isListGroupApplicationsRequest_Id()
-}
+func (*ListBansRequest) ProtoMessage() {}
 
-type ListGroupApplicationsRequest_GroupId struct {
-	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3,oneof"`
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ListGroupApplicationsRequest_AccountName struct {
-	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3,oneof"`
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (*ListGroupApplicationsRequest_GroupId) isListGroupApplicationsRequest_Id() {}
-
-func (*ListGroupApplicationsRequest_AccountName) isListGroupApplicationsRequest_Id() {}
-
-type ListGroupApplicationsResponse struct {
+type ListBansResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Applications []*GroupApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Bans []*Ban `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
 }
 
-func (x *ListGroupApplicationsResponse) Reset() {
-	*x = ListGroupApplicationsResponse{}
-	mi := &file_service_proto_msgTypes[18]
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	mi := &file_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupApplicationsResponse) String() string {
+func (x *ListBansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupApplicationsResponse) ProtoMessage() {}
+func (*ListBansResponse) ProtoMessage() {}
 
-func (x *ListGroupApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[18]
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,42 +6004,47 @@ func (x *ListGroupApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *ListGroupApplicationsResponse) GetApplications() []*GroupApplication {
+func (x *ListBansResponse) GetBans() []*Ban {
 	if x != nil {
-		return x.Applications
+		return x.Bans
 	}
 	return nil
 }
 
-type DeleteGroupApplicationRequest struct {
+type Subscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Empty for anonymous subscribers.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The subscribed method, e.g. "SubscribeGroups".
+	Method       string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	GroupId      string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StartedAtSec int64  `protobuf:"varint,5,opt,name=started_at_sec,json=startedAtSec,proto3" json:"started_at_sec,omitempty"`
 }
 
-func (x *DeleteGroupApplicationRequest) Reset() {
-	*x = DeleteGroupApplicationRequest{}
-	mi := &file_service_proto_msgTypes[19]
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupApplicationRequest) String() string {
+func (x *Subscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationRequest) ProtoMessage() {}
+func (*Subscription) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[19]
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,46 +6055,69 @@ func (x *DeleteGroupApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteGroupApplicationRequest) GetGroupId() string {
+func (x *Subscription) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteGroupApplicationRequest) GetApplicationId() string {
+func (x *Subscription) GetAccountName() string {
 	if x != nil {
-		return x.ApplicationId
+		return x.AccountName
 	}
 	return ""
 }
 
-type DeleteGroupApplicationResponse struct {
+func (x *Subscription) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Subscription) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Subscription) GetStartedAtSec() int64 {
+	if x != nil {
+		return x.StartedAtSec
+	}
+	return 0
+}
+
+type ListReportsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	IncludeResolved bool `protobuf:"varint,1,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
 }
 
-func (x *DeleteGroupApplicationResponse) Reset() {
-	*x = DeleteGroupApplicationResponse{}
-	mi := &file_service_proto_msgTypes[20]
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupApplicationResponse) String() string {
+func (x *ListReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationResponse) ProtoMessage() {}
+func (*ListReportsRequest) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[20]
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,35 +6128,41 @@ func (x *DeleteGroupApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{92}
 }
 
-// TODO: We need updates similar to GroupsUpdate
-type SubscribeGroupApplicationsRequest struct {
+func (x *ListReportsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+type ListReportsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reports []*Report `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 }
 
-func (x *SubscribeGroupApplicationsRequest) Reset() {
-	*x = SubscribeGroupApplicationsRequest{}
-	mi := &file_service_proto_msgTypes[21]
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeGroupApplicationsRequest) String() string {
+func (x *ListReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeGroupApplicationsRequest) ProtoMessage() {}
+func (*ListReportsResponse) ProtoMessage() {}
 
-func (x *SubscribeGroupApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[21]
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,46 +6173,46 @@ func (x *SubscribeGroupApplicationsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeGroupApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeGroupApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SubscribeGroupApplicationsRequest) GetGroupId() string {
+func (x *ListReportsResponse) GetReports() []*Report {
 	if x != nil {
-		return x.GroupId
+		return x.Reports
 	}
-	return ""
+	return nil
 }
 
-type GroupApplicationUpdate struct {
+// ResolveReportRequest resolves the report together with all other open
+// reports of the same target.
+type ResolveReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Update:
-	//
-	//	*GroupApplicationUpdate_NewApplication
-	//	*GroupApplicationUpdate_UpdatedApplication
-	//	*GroupApplicationUpdate_RemovedApplicationId
-	Update isGroupApplicationUpdate_Update `protobuf_oneof:"update"`
+	ReportId   string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Resolution string `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// Makes a group hidden by reports visible again.
+	UnhideGroup bool `protobuf:"varint,3,opt,name=unhide_group,json=unhideGroup,proto3" json:"unhide_group,omitempty"`
 }
 
-func (x *GroupApplicationUpdate) Reset() {
-	*x = GroupApplicationUpdate{}
-	mi := &file_service_proto_msgTypes[22]
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupApplicationUpdate) String() string {
+func (x *ResolveReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupApplicationUpdate) ProtoMessage() {}
+func (*ResolveReportRequest) ProtoMessage() {}
 
-func (x *GroupApplicationUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[22]
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,82 +6223,98 @@ func (x *GroupApplicationUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupApplicationUpdate.ProtoReflect.Descriptor instead.
-func (*GroupApplicationUpdate) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (m *GroupApplicationUpdate) GetUpdate() isGroupApplicationUpdate_Update {
-	if m != nil {
-		return m.Update
+func (x *ResolveReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupApplicationUpdate) GetNewApplication() *GroupApplication {
-	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_NewApplication); ok {
-		return x.NewApplication
+func (x *ResolveReportRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupApplicationUpdate) GetUpdatedApplication() *GroupApplication {
-	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_UpdatedApplication); ok {
-		return x.UpdatedApplication
+func (x *ResolveReportRequest) GetUnhideGroup() bool {
+	if x != nil {
+		return x.UnhideGroup
 	}
-	return nil
+	return false
 }
 
-func (x *GroupApplicationUpdate) GetRemovedApplicationId() string {
-	if x, ok := x.GetUpdate().(*GroupApplicationUpdate_RemovedApplicationId); ok {
-		return x.RemovedApplicationId
-	}
-	return ""
-}
+type ResolveReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type isGroupApplicationUpdate_Update interface {
-	isGroupApplicationUpdate_Update()
+	Reports []*Report `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 }
 
-type GroupApplicationUpdate_NewApplication struct {
-	NewApplication *GroupApplication `protobuf:"bytes,1,opt,name=new_application,json=newApplication,proto3,oneof"`
+func (x *ResolveReportResponse) Reset() {
+	*x = ResolveReportResponse{}
+	mi := &file_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GroupApplicationUpdate_UpdatedApplication struct {
-	UpdatedApplication *GroupApplication `protobuf:"bytes,2,opt,name=updated_application,json=updatedApplication,proto3,oneof"`
+func (x *ResolveReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GroupApplicationUpdate_RemovedApplicationId struct {
-	RemovedApplicationId string `protobuf:"bytes,3,opt,name=removed_application_id,json=removedApplicationId,proto3,oneof"`
-}
+func (*ResolveReportResponse) ProtoMessage() {}
 
-func (*GroupApplicationUpdate_NewApplication) isGroupApplicationUpdate_Update() {}
+func (x *ResolveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*GroupApplicationUpdate_UpdatedApplication) isGroupApplicationUpdate_Update() {}
+// Deprecated: Use ResolveReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{95}
+}
 
-func (*GroupApplicationUpdate_RemovedApplicationId) isGroupApplicationUpdate_Update() {}
+func (x *ResolveReportResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
 
-type HeartbeatRequest struct {
+type ListSubscriptionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HeartbeatRequest) Reset() {
-	*x = HeartbeatRequest{}
-	mi := &file_service_proto_msgTypes[23]
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeartbeatRequest) String() string {
+func (x *ListSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartbeatRequest) ProtoMessage() {}
+func (*ListSubscriptionsRequest) ProtoMessage() {}
 
-func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[23]
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,32 +6325,34 @@ func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
-func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{96}
 }
 
-type HeartbeatResponse struct {
+type ListSubscriptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 }
 
-func (x *HeartbeatResponse) Reset() {
-	*x = HeartbeatResponse{}
-	mi := &file_service_proto_msgTypes[24]
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeartbeatResponse) String() string {
+func (x *ListSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartbeatResponse) ProtoMessage() {}
+func (*ListSubscriptionsResponse) ProtoMessage() {}
 
-func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[24]
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,16 +6363,23 @@ func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
-func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
 }
 
 var File_service_proto protoreflect.FileDescriptor
 
 var file_service_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x06, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x22, 0x97, 0x04, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
 	0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f,
@@ -1525,213 +6388,1024 @@ var file_service_proto_rawDesc = []byte{
 	0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a,
 	0x12, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
 	0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x0a, 0x13, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xff, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70,
-	0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x49, 0x64, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b, 0x69, 0x6c,
-	0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x24, 0x0a,
-	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x39, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x61, 0x6c,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69,
+	0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a,
+	0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x12, 0x37, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x64, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x53, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0b,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x22, 0x3a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x90, 0x06,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0d,
+	0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4b, 0x69, 0x6c,
+	0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63,
+	0x12, 0x37, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x20, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x53, 0x65,
+	0x63, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63,
+	0x22, 0x54, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x2a,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6e, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x39, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
 	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x3a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32,
-	0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x22, 0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x34, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
-	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x3b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
-	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
-	0xde, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
-	0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63,
-	0x22, 0xc1, 0x01, 0x0a, 0x09, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x0e,
-	0x0a, 0x02, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6c, 0x69, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x73, 0x6b, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x73,
-	0x6b, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x66, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x75, 0x66, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x33, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x34, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x35, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x35, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x36, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x36, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x37, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x37, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x38, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x77, 0x38, 0x22, 0x3a, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x34, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x87, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x12, 0x25,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x72, 0x74, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x29, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62, 0x6f, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6e, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x6f, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xad, 0x04, 0x0a, 0x10, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x6b,
+	0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x43, 0x0a, 0x11, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6f, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x74, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x45, 0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x65,
+	0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63,
+	0x12, 0x17, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x09, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x6c, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x73, 0x6b, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x62, 0x73, 0x6b, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x66, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x66, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x31, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x32, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x33, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x33, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x34, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x34, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x35, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x35, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x36, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x36, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x37, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x37, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x38, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x77, 0x38, 0x22, 0x99, 0x02, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x45, 0x6c, 0x69, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x65, 0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
 	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
-	0x22, 0x5c, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6f, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x45, 0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x65, 0x6c, 0x69, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x1e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x22,
+	0x5d, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61,
+	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x22, 0xdf, 0x02, 0x0a, 0x16, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x43,
+	0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
 	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f,
-	0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x66, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a,
-	0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0xec, 0x01,
-	0x0a, 0x16, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6e,
-	0x65, 0x77, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a,
-	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x32,
-	0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x13, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x91, 0x01, 0x0a, 0x06, 0x52, 0x61, 0x69, 0x64, 0x49, 0x64,
-	0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f,
-	0x31, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47,
-	0x5f, 0x32, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e,
-	0x47, 0x5f, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57, 0x49,
-	0x4e, 0x47, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57,
-	0x49, 0x4e, 0x47, 0x5f, 0x35, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f,
-	0x57, 0x49, 0x4e, 0x47, 0x5f, 0x36, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44,
-	0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x37, 0x10, 0x07, 0x2a, 0x41, 0x0a, 0x0b, 0x4b, 0x69, 0x6c,
-	0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x50, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x50, 0x5f, 0x4c,
-	0x49, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x50, 0x5f, 0x42, 0x53, 0x4b, 0x50, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x50, 0x5f, 0x55, 0x46, 0x45, 0x10, 0x03, 0x32, 0xd8, 0x07, 0x0a,
-	0x0a, 0x4c, 0x66, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32,
-	0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x54, 0x0a, 0x14,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x97, 0x02, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6f, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x45, 0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x65, 0x6c, 0x69, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a,
+	0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x53, 0x65, 0x63, 0x22, 0x71, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61,
+	0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x4f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a,
+	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x2d, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x13, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x15,
+	0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x22, 0x59, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x3c, 0x0a, 0x17,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x19, 0x55, 0x6e, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x6e, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x77, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x12, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x68, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9b, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x27, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x3a, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x22,
+	0x43, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0xa9, 0x01, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x53, 0x65, 0x63, 0x22, 0x9d, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3c, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x62, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a,
+	0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x4c, 0x0a, 0x17, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e,
+	0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x20,
+	0x0a, 0x1e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x71, 0x0a, 0x11, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x22, 0x33, 0x0a, 0x12, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x62, 0x61, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x42, 0x61, 0x6e, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x22, 0x50, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x61,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e,
+	0x62, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x61, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x3f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x41, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x91, 0x01, 0x0a, 0x06, 0x52, 0x61,
+	0x69, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f, 0x57,
+	0x49, 0x4e, 0x47, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44, 0x5f,
+	0x57, 0x49, 0x4e, 0x47, 0x5f, 0x32, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49, 0x44,
+	0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x49,
+	0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41,
+	0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x35, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x41, 0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x36, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x41, 0x49, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x5f, 0x37, 0x10, 0x07, 0x2a, 0x57, 0x0a,
+	0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50,
+	0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x53, 0x49, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x49,
+	0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x92, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
+	0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x9d, 0x01, 0x0a, 0x07,
+	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44,
+	0x41, 0x59, 0x5f, 0x53, 0x55, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x57,
+	0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x54, 0x55, 0x45, 0x53, 0x44,
+	0x41, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f,
+	0x57, 0x45, 0x44, 0x4e, 0x45, 0x53, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x57,
+	0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x54, 0x48, 0x55, 0x52, 0x53, 0x44, 0x41, 0x59, 0x10,
+	0x04, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x46, 0x52, 0x49,
+	0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59,
+	0x5f, 0x53, 0x41, 0x54, 0x55, 0x52, 0x44, 0x41, 0x59, 0x10, 0x06, 0x2a, 0x3e, 0x0a, 0x09, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x2a, 0x65, 0x0a, 0x10, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x02, 0x2a, 0x78, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x52,
+	0x4f, 0x4f, 0x46, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x50, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x45, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54,
+	0x5f, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x03, 0x2a, 0x75, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x50, 0x53, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4e, 0x5f, 0x44, 0x50, 0x53,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x10,
+	0x03, 0x2a, 0x43, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x4f, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x4e, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x41, 0x43,
+	0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x66, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x47, 0x55, 0x41, 0x52, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52,
+	0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x52, 0x49, 0x4f, 0x52,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x45, 0x52, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x52, 0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x52,
+	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x48, 0x49, 0x45, 0x46, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x46,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c,
+	0x49, 0x53, 0x54, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x53, 0x4d, 0x45, 0x52, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16,
+	0x50, 0x52, 0x4f, 0x46, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x43, 0x52, 0x4f,
+	0x4d, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x46,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10,
+	0x09, 0x2a, 0xd3, 0x05, 0x0a, 0x09, 0x45, 0x6c, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x1a, 0x0a, 0x16, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45,
+	0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x44, 0x52, 0x41, 0x47, 0x4f, 0x4e,
+	0x48, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54,
+	0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x42, 0x52, 0x41, 0x4e, 0x44,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x5f, 0x57, 0x49, 0x4c, 0x4c, 0x42, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x42, 0x45, 0x52, 0x53,
+	0x45, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4c, 0x49, 0x54, 0x45,
+	0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x53, 0x50, 0x45, 0x4c, 0x4c, 0x42, 0x52, 0x45, 0x41, 0x4b,
+	0x45, 0x52, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50,
+	0x45, 0x43, 0x5f, 0x42, 0x4c, 0x41, 0x44, 0x45, 0x53, 0x57, 0x4f, 0x52, 0x4e, 0x10, 0x06, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x53, 0x43,
+	0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54,
+	0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x48, 0x4f, 0x4c, 0x4f, 0x53, 0x4d, 0x49, 0x54, 0x48,
+	0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x5f, 0x4d, 0x45, 0x43, 0x48, 0x41, 0x4e, 0x49, 0x53, 0x54, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
+	0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x44, 0x52, 0x55, 0x49, 0x44,
+	0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x5f, 0x53, 0x4f, 0x55, 0x4c, 0x42, 0x45, 0x41, 0x53, 0x54, 0x10, 0x0b, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x54, 0x41, 0x4d,
+	0x45, 0x44, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50,
+	0x45, 0x43, 0x5f, 0x44, 0x41, 0x52, 0x45, 0x44, 0x45, 0x56, 0x49, 0x4c, 0x10, 0x0d, 0x12, 0x16,
+	0x0a, 0x12, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x44, 0x45, 0x41,
+	0x44, 0x45, 0x59, 0x45, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x45, 0x52, 0x10, 0x0f, 0x12, 0x16,
+	0x0a, 0x12, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x54, 0x45, 0x4d,
+	0x50, 0x45, 0x53, 0x54, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x5f, 0x57, 0x45, 0x41, 0x56, 0x45, 0x52, 0x10, 0x11, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x41, 0x54, 0x41,
+	0x4c, 0x59, 0x53, 0x54, 0x10, 0x12, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x52, 0x4f, 0x4e, 0x4f, 0x4d, 0x41, 0x4e, 0x43, 0x45,
+	0x52, 0x10, 0x13, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45,
+	0x43, 0x5f, 0x4d, 0x49, 0x52, 0x41, 0x47, 0x45, 0x10, 0x14, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4c,
+	0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x4f, 0x53,
+	0x4f, 0x10, 0x15, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45,
+	0x43, 0x5f, 0x52, 0x45, 0x41, 0x50, 0x45, 0x52, 0x10, 0x16, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4c,
+	0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x47, 0x45,
+	0x10, 0x17, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x5f, 0x48, 0x41, 0x52, 0x42, 0x49, 0x4e, 0x47, 0x45, 0x52, 0x10, 0x18, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x48, 0x45, 0x52, 0x41, 0x4c,
+	0x44, 0x10, 0x19, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45,
+	0x43, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x41, 0x44, 0x45, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15,
+	0x45, 0x4c, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x56, 0x49, 0x4e, 0x44, 0x49,
+	0x43, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x1b, 0x2a, 0x41, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x50, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x50, 0x5f, 0x4c, 0x49, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x50, 0x5f, 0x42, 0x53, 0x4b, 0x50, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x4b, 0x50, 0x5f, 0x55, 0x46, 0x45, 0x10, 0x03, 0x32, 0xde, 0x14, 0x0a, 0x0a, 0x4c,
+	0x66, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x17, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x2e,
-	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
-	0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x69, 0x0a,
-	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x77, 0x32, 0x6c,
-	0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67,
-	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67,
-	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x12, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x77, 0x32,
-	0x6c, 0x66, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x4b, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x29, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42,
+	0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x55, 0x6e, 0x77, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x77, 0x32, 0x6c,
+	0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x98, 0x01, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xee, 0x05, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x16, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x77,
+	0x32, 0x6c, 0x66, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a,
+	0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x77, 0x32,
+	0x6c, 0x66, 0x67, 0x2e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x42,
+	0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x62,
+	0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67,
+	0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66,
+	0x67, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x77, 0x32, 0x6c, 0x66, 0x67, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0xaa,
 	0x02, 0x0c, 0x47, 0x77, 0x32, 0x4c, 0x66, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -1749,78 +7423,283 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_service_proto_goTypes = []any{
 	(RaidId)(0),                               // 0: gw2lfg.RaidId
-	(KillProofId)(0),                          // 1: gw2lfg.KillProofId
-	(*CreateGroupRequest)(nil),                // 2: gw2lfg.CreateGroupRequest
-	(*CreateGroupResponse)(nil),               // 3: gw2lfg.CreateGroupResponse
-	(*Group)(nil),                             // 4: gw2lfg.Group
-	(*UpdateGroupRequest)(nil),                // 5: gw2lfg.UpdateGroupRequest
-	(*UpdateGroupResponse)(nil),               // 6: gw2lfg.UpdateGroupResponse
-	(*DeleteGroupRequest)(nil),                // 7: gw2lfg.DeleteGroupRequest
-	(*DeleteGroupResponse)(nil),               // 8: gw2lfg.DeleteGroupResponse
-	(*SubscribeGroupsRequest)(nil),            // 9: gw2lfg.SubscribeGroupsRequest
-	(*GroupsUpdate)(nil),                      // 10: gw2lfg.GroupsUpdate
-	(*ListGroupsRequest)(nil),                 // 11: gw2lfg.ListGroupsRequest
-	(*ListGroupsResponse)(nil),                // 12: gw2lfg.ListGroupsResponse
-	(*GroupApplication)(nil),                  // 13: gw2lfg.GroupApplication
-	(*KillProof)(nil),                         // 14: gw2lfg.KillProof
-	(*CreateGroupApplicationRequest)(nil),     // 15: gw2lfg.CreateGroupApplicationRequest
-	(*CreateGroupApplicationResponse)(nil),    // 16: gw2lfg.CreateGroupApplicationResponse
-	(*UpdateGroupApplicationRequest)(nil),     // 17: gw2lfg.UpdateGroupApplicationRequest
-	(*UpdateGroupApplicationResponse)(nil),    // 18: gw2lfg.UpdateGroupApplicationResponse
-	(*ListGroupApplicationsRequest)(nil),      // 19: gw2lfg.ListGroupApplicationsRequest
-	(*ListGroupApplicationsResponse)(nil),     // 20: gw2lfg.ListGroupApplicationsResponse
-	(*DeleteGroupApplicationRequest)(nil),     // 21: gw2lfg.DeleteGroupApplicationRequest
-	(*DeleteGroupApplicationResponse)(nil),    // 22: gw2lfg.DeleteGroupApplicationResponse
-	(*SubscribeGroupApplicationsRequest)(nil), // 23: gw2lfg.SubscribeGroupApplicationsRequest
-	(*GroupApplicationUpdate)(nil),            // 24: gw2lfg.GroupApplicationUpdate
-	(*HeartbeatRequest)(nil),                  // 25: gw2lfg.HeartbeatRequest
-	(*HeartbeatResponse)(nil),                 // 26: gw2lfg.HeartbeatResponse
+	(GroupVisibility)(0),                      // 1: gw2lfg.GroupVisibility
+	(GroupState)(0),                           // 2: gw2lfg.GroupState
+	(Weekday)(0),                              // 3: gw2lfg.Weekday
+	(GroupSort)(0),                            // 4: gw2lfg.GroupSort
+	(ReportTargetType)(0),                     // 5: gw2lfg.ReportTargetType
+	(AutoAcceptMode)(0),                       // 6: gw2lfg.AutoAcceptMode
+	(ApplicationStatus)(0),                    // 7: gw2lfg.ApplicationStatus
+	(Role)(0),                                 // 8: gw2lfg.Role
+	(Boon)(0),                                 // 9: gw2lfg.Boon
+	(Profession)(0),                           // 10: gw2lfg.Profession
+	(EliteSpec)(0),                            // 11: gw2lfg.EliteSpec
+	(KillProofId)(0),                          // 12: gw2lfg.KillProofId
+	(*CreateGroupRequest)(nil),                // 13: gw2lfg.CreateGroupRequest
+	(*CreateGroupResponse)(nil),               // 14: gw2lfg.CreateGroupResponse
+	(*Group)(nil),                             // 15: gw2lfg.Group
+	(*AutoAccept)(nil),                        // 16: gw2lfg.AutoAccept
+	(*RoleSlot)(nil),                          // 17: gw2lfg.RoleSlot
+	(*GetGroupRequest)(nil),                   // 18: gw2lfg.GetGroupRequest
+	(*GetGroupResponse)(nil),                  // 19: gw2lfg.GetGroupResponse
+	(*UpdateGroupRequest)(nil),                // 20: gw2lfg.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),               // 21: gw2lfg.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),                // 22: gw2lfg.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),               // 23: gw2lfg.DeleteGroupResponse
+	(*SubscribeGroupsRequest)(nil),            // 24: gw2lfg.SubscribeGroupsRequest
+	(*GroupsUpdate)(nil),                      // 25: gw2lfg.GroupsUpdate
+	(*ListGroupsRequest)(nil),                 // 26: gw2lfg.ListGroupsRequest
+	(*ListGroupsResponse)(nil),                // 27: gw2lfg.ListGroupsResponse
+	(*GroupApplication)(nil),                  // 28: gw2lfg.GroupApplication
+	(*AccountProfile)(nil),                    // 29: gw2lfg.AccountProfile
+	(*KillProof)(nil),                         // 30: gw2lfg.KillProof
+	(*CreateGroupApplicationRequest)(nil),     // 31: gw2lfg.CreateGroupApplicationRequest
+	(*CreateGroupApplicationResponse)(nil),    // 32: gw2lfg.CreateGroupApplicationResponse
+	(*UpdateGroupApplicationRequest)(nil),     // 33: gw2lfg.UpdateGroupApplicationRequest
+	(*UpdateGroupApplicationResponse)(nil),    // 34: gw2lfg.UpdateGroupApplicationResponse
+	(*ListGroupApplicationsRequest)(nil),      // 35: gw2lfg.ListGroupApplicationsRequest
+	(*ListGroupApplicationsResponse)(nil),     // 36: gw2lfg.ListGroupApplicationsResponse
+	(*DeleteGroupApplicationRequest)(nil),     // 37: gw2lfg.DeleteGroupApplicationRequest
+	(*DeleteGroupApplicationResponse)(nil),    // 38: gw2lfg.DeleteGroupApplicationResponse
+	(*SubscribeGroupApplicationsRequest)(nil), // 39: gw2lfg.SubscribeGroupApplicationsRequest
+	(*GroupApplicationUpdate)(nil),            // 40: gw2lfg.GroupApplicationUpdate
+	(*Invitation)(nil),                        // 41: gw2lfg.Invitation
+	(*InviteToGroupRequest)(nil),              // 42: gw2lfg.InviteToGroupRequest
+	(*InviteToGroupResponse)(nil),             // 43: gw2lfg.InviteToGroupResponse
+	(*RespondToInvitationRequest)(nil),        // 44: gw2lfg.RespondToInvitationRequest
+	(*RespondToInvitationResponse)(nil),       // 45: gw2lfg.RespondToInvitationResponse
+	(*CancelInvitationRequest)(nil),           // 46: gw2lfg.CancelInvitationRequest
+	(*CancelInvitationResponse)(nil),          // 47: gw2lfg.CancelInvitationResponse
+	(*ListInvitationsRequest)(nil),            // 48: gw2lfg.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),           // 49: gw2lfg.ListInvitationsResponse
+	(*ReviewGroupApplicationRequest)(nil),     // 50: gw2lfg.ReviewGroupApplicationRequest
+	(*ReviewGroupApplicationResponse)(nil),    // 51: gw2lfg.ReviewGroupApplicationResponse
+	(*HeartbeatRequest)(nil),                  // 52: gw2lfg.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                 // 53: gw2lfg.HeartbeatResponse
+	(*GroupTemplate)(nil),                     // 54: gw2lfg.GroupTemplate
+	(*Recurrence)(nil),                        // 55: gw2lfg.Recurrence
+	(*CreateGroupTemplateRequest)(nil),        // 56: gw2lfg.CreateGroupTemplateRequest
+	(*CreateGroupTemplateResponse)(nil),       // 57: gw2lfg.CreateGroupTemplateResponse
+	(*UpdateGroupTemplateRequest)(nil),        // 58: gw2lfg.UpdateGroupTemplateRequest
+	(*UpdateGroupTemplateResponse)(nil),       // 59: gw2lfg.UpdateGroupTemplateResponse
+	(*DeleteGroupTemplateRequest)(nil),        // 60: gw2lfg.DeleteGroupTemplateRequest
+	(*DeleteGroupTemplateResponse)(nil),       // 61: gw2lfg.DeleteGroupTemplateResponse
+	(*ListGroupTemplatesRequest)(nil),         // 62: gw2lfg.ListGroupTemplatesRequest
+	(*ListGroupTemplatesResponse)(nil),        // 63: gw2lfg.ListGroupTemplatesResponse
+	(*GetCalendarFeedRequest)(nil),            // 64: gw2lfg.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),           // 65: gw2lfg.GetCalendarFeedResponse
+	(*BlockAccountRequest)(nil),               // 66: gw2lfg.BlockAccountRequest
+	(*BlockAccountResponse)(nil),              // 67: gw2lfg.BlockAccountResponse
+	(*UnblockAccountRequest)(nil),             // 68: gw2lfg.UnblockAccountRequest
+	(*UnblockAccountResponse)(nil),            // 69: gw2lfg.UnblockAccountResponse
+	(*ListBlockedRequest)(nil),                // 70: gw2lfg.ListBlockedRequest
+	(*ListBlockedResponse)(nil),               // 71: gw2lfg.ListBlockedResponse
+	(*BlockedAccount)(nil),                    // 72: gw2lfg.BlockedAccount
+	(*WhitelistAccountRequest)(nil),           // 73: gw2lfg.WhitelistAccountRequest
+	(*WhitelistAccountResponse)(nil),          // 74: gw2lfg.WhitelistAccountResponse
+	(*UnwhitelistAccountRequest)(nil),         // 75: gw2lfg.UnwhitelistAccountRequest
+	(*UnwhitelistAccountResponse)(nil),        // 76: gw2lfg.UnwhitelistAccountResponse
+	(*ListWhitelistedRequest)(nil),            // 77: gw2lfg.ListWhitelistedRequest
+	(*ListWhitelistedResponse)(nil),           // 78: gw2lfg.ListWhitelistedResponse
+	(*WhitelistedAccount)(nil),                // 79: gw2lfg.WhitelistedAccount
+	(*ReportGroupRequest)(nil),                // 80: gw2lfg.ReportGroupRequest
+	(*ReportGroupResponse)(nil),               // 81: gw2lfg.ReportGroupResponse
+	(*ReportApplicationRequest)(nil),          // 82: gw2lfg.ReportApplicationRequest
+	(*ReportApplicationResponse)(nil),         // 83: gw2lfg.ReportApplicationResponse
+	(*Report)(nil),                            // 84: gw2lfg.Report
+	(*LoginRequest)(nil),                      // 85: gw2lfg.LoginRequest
+	(*LoginResponse)(nil),                     // 86: gw2lfg.LoginResponse
+	(*RefreshSessionRequest)(nil),             // 87: gw2lfg.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),            // 88: gw2lfg.RefreshSessionResponse
+	(*Session)(nil),                           // 89: gw2lfg.Session
+	(*Ban)(nil),                               // 90: gw2lfg.Ban
+	(*AdminGroup)(nil),                        // 91: gw2lfg.AdminGroup
+	(*AdminListGroupsRequest)(nil),            // 92: gw2lfg.AdminListGroupsRequest
+	(*AdminListGroupsResponse)(nil),           // 93: gw2lfg.AdminListGroupsResponse
+	(*ForceDeleteGroupRequest)(nil),           // 94: gw2lfg.ForceDeleteGroupRequest
+	(*ForceDeleteGroupResponse)(nil),          // 95: gw2lfg.ForceDeleteGroupResponse
+	(*ForceDeleteApplicationRequest)(nil),     // 96: gw2lfg.ForceDeleteApplicationRequest
+	(*ForceDeleteApplicationResponse)(nil),    // 97: gw2lfg.ForceDeleteApplicationResponse
+	(*BanAccountRequest)(nil),                 // 98: gw2lfg.BanAccountRequest
+	(*BanAccountResponse)(nil),                // 99: gw2lfg.BanAccountResponse
+	(*UnbanAccountRequest)(nil),               // 100: gw2lfg.UnbanAccountRequest
+	(*UnbanAccountResponse)(nil),              // 101: gw2lfg.UnbanAccountResponse
+	(*ListBansRequest)(nil),                   // 102: gw2lfg.ListBansRequest
+	(*ListBansResponse)(nil),                  // 103: gw2lfg.ListBansResponse
+	(*Subscription)(nil),                      // 104: gw2lfg.Subscription
+	(*ListReportsRequest)(nil),                // 105: gw2lfg.ListReportsRequest
+	(*ListReportsResponse)(nil),               // 106: gw2lfg.ListReportsResponse
+	(*ResolveReportRequest)(nil),              // 107: gw2lfg.ResolveReportRequest
+	(*ResolveReportResponse)(nil),             // 108: gw2lfg.ResolveReportResponse
+	(*ListSubscriptionsRequest)(nil),          // 109: gw2lfg.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),         // 110: gw2lfg.ListSubscriptionsResponse
 }
 var file_service_proto_depIdxs = []int32{
-	1,  // 0: gw2lfg.CreateGroupRequest.kill_proof_id:type_name -> gw2lfg.KillProofId
-	4,  // 1: gw2lfg.CreateGroupResponse.group:type_name -> gw2lfg.Group
-	1,  // 2: gw2lfg.Group.kill_proof_id:type_name -> gw2lfg.KillProofId
-	4,  // 3: gw2lfg.UpdateGroupRequest.group:type_name -> gw2lfg.Group
-	4,  // 4: gw2lfg.UpdateGroupResponse.group:type_name -> gw2lfg.Group
-	4,  // 5: gw2lfg.GroupsUpdate.new_group:type_name -> gw2lfg.Group
-	4,  // 6: gw2lfg.GroupsUpdate.updated_group:type_name -> gw2lfg.Group
-	4,  // 7: gw2lfg.ListGroupsResponse.groups:type_name -> gw2lfg.Group
-	14, // 8: gw2lfg.GroupApplication.kill_proof:type_name -> gw2lfg.KillProof
-	13, // 9: gw2lfg.CreateGroupApplicationResponse.application:type_name -> gw2lfg.GroupApplication
-	13, // 10: gw2lfg.ListGroupApplicationsResponse.applications:type_name -> gw2lfg.GroupApplication
-	13, // 11: gw2lfg.GroupApplicationUpdate.new_application:type_name -> gw2lfg.GroupApplication
-	13, // 12: gw2lfg.GroupApplicationUpdate.updated_application:type_name -> gw2lfg.GroupApplication
-	2,  // 13: gw2lfg.LfgService.CreateGroup:input_type -> gw2lfg.CreateGroupRequest
-	5,  // 14: gw2lfg.LfgService.UpdateGroup:input_type -> gw2lfg.UpdateGroupRequest
-	11, // 15: gw2lfg.LfgService.ListGroups:input_type -> gw2lfg.ListGroupsRequest
-	7,  // 16: gw2lfg.LfgService.DeleteGroup:input_type -> gw2lfg.DeleteGroupRequest
-	9,  // 17: gw2lfg.LfgService.SubscribeGroups:input_type -> gw2lfg.SubscribeGroupsRequest
-	15, // 18: gw2lfg.LfgService.CreateGroupApplication:input_type -> gw2lfg.CreateGroupApplicationRequest
-	17, // 19: gw2lfg.LfgService.UpdateGroupApplication:input_type -> gw2lfg.UpdateGroupApplicationRequest
-	19, // 20: gw2lfg.LfgService.ListGroupApplications:input_type -> gw2lfg.ListGroupApplicationsRequest
-	21, // 21: gw2lfg.LfgService.DeleteGroupApplication:input_type -> gw2lfg.DeleteGroupApplicationRequest
-	23, // 22: gw2lfg.LfgService.SubscribeGroupApplications:input_type -> gw2lfg.SubscribeGroupApplicationsRequest
-	25, // 23: gw2lfg.LfgService.Heartbeat:input_type -> gw2lfg.HeartbeatRequest
-	3,  // 24: gw2lfg.LfgService.CreateGroup:output_type -> gw2lfg.CreateGroupResponse
-	6,  // 25: gw2lfg.LfgService.UpdateGroup:output_type -> gw2lfg.UpdateGroupResponse
-	12, // 26: gw2lfg.LfgService.ListGroups:output_type -> gw2lfg.ListGroupsResponse
-	8,  // 27: gw2lfg.LfgService.DeleteGroup:output_type -> gw2lfg.DeleteGroupResponse
-	10, // 28: gw2lfg.LfgService.SubscribeGroups:output_type -> gw2lfg.GroupsUpdate
-	16, // 29: gw2lfg.LfgService.CreateGroupApplication:output_type -> gw2lfg.CreateGroupApplicationResponse
-	18, // 30: gw2lfg.LfgService.UpdateGroupApplication:output_type -> gw2lfg.UpdateGroupApplicationResponse
-	20, // 31: gw2lfg.LfgService.ListGroupApplications:output_type -> gw2lfg.ListGroupApplicationsResponse
-	22, // 32: gw2lfg.LfgService.DeleteGroupApplication:output_type -> gw2lfg.DeleteGroupApplicationResponse
-	24, // 33: gw2lfg.LfgService.SubscribeGroupApplications:output_type -> gw2lfg.GroupApplicationUpdate
-	26, // 34: gw2lfg.LfgService.Heartbeat:output_type -> gw2lfg.HeartbeatResponse
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	12,  // 0: gw2lfg.CreateGroupRequest.kill_proof_id:type_name -> gw2lfg.KillProofId
+	1,   // 1: gw2lfg.CreateGroupRequest.visibility:type_name -> gw2lfg.GroupVisibility
+	17,  // 2: gw2lfg.CreateGroupRequest.slots:type_name -> gw2lfg.RoleSlot
+	16,  // 3: gw2lfg.CreateGroupRequest.auto_accept:type_name -> gw2lfg.AutoAccept
+	15,  // 4: gw2lfg.CreateGroupResponse.group:type_name -> gw2lfg.Group
+	12,  // 5: gw2lfg.Group.kill_proof_id:type_name -> gw2lfg.KillProofId
+	1,   // 6: gw2lfg.Group.visibility:type_name -> gw2lfg.GroupVisibility
+	2,   // 7: gw2lfg.Group.state:type_name -> gw2lfg.GroupState
+	17,  // 8: gw2lfg.Group.slots:type_name -> gw2lfg.RoleSlot
+	16,  // 9: gw2lfg.Group.auto_accept:type_name -> gw2lfg.AutoAccept
+	6,   // 10: gw2lfg.AutoAccept.mode:type_name -> gw2lfg.AutoAcceptMode
+	8,   // 11: gw2lfg.RoleSlot.role:type_name -> gw2lfg.Role
+	9,   // 12: gw2lfg.RoleSlot.boon:type_name -> gw2lfg.Boon
+	15,  // 13: gw2lfg.GetGroupResponse.group:type_name -> gw2lfg.Group
+	15,  // 14: gw2lfg.UpdateGroupRequest.group:type_name -> gw2lfg.Group
+	15,  // 15: gw2lfg.UpdateGroupResponse.group:type_name -> gw2lfg.Group
+	15,  // 16: gw2lfg.GroupsUpdate.new_group:type_name -> gw2lfg.Group
+	15,  // 17: gw2lfg.GroupsUpdate.updated_group:type_name -> gw2lfg.Group
+	4,   // 18: gw2lfg.ListGroupsRequest.sort:type_name -> gw2lfg.GroupSort
+	8,   // 19: gw2lfg.ListGroupsRequest.open_role:type_name -> gw2lfg.Role
+	9,   // 20: gw2lfg.ListGroupsRequest.open_boon:type_name -> gw2lfg.Boon
+	15,  // 21: gw2lfg.ListGroupsResponse.groups:type_name -> gw2lfg.Group
+	30,  // 22: gw2lfg.GroupApplication.kill_proof:type_name -> gw2lfg.KillProof
+	29,  // 23: gw2lfg.GroupApplication.applicant_profile:type_name -> gw2lfg.AccountProfile
+	8,   // 24: gw2lfg.GroupApplication.role:type_name -> gw2lfg.Role
+	9,   // 25: gw2lfg.GroupApplication.boon:type_name -> gw2lfg.Boon
+	10,  // 26: gw2lfg.GroupApplication.profession:type_name -> gw2lfg.Profession
+	11,  // 27: gw2lfg.GroupApplication.elite_spec:type_name -> gw2lfg.EliteSpec
+	7,   // 28: gw2lfg.GroupApplication.status:type_name -> gw2lfg.ApplicationStatus
+	8,   // 29: gw2lfg.CreateGroupApplicationRequest.role:type_name -> gw2lfg.Role
+	9,   // 30: gw2lfg.CreateGroupApplicationRequest.boon:type_name -> gw2lfg.Boon
+	10,  // 31: gw2lfg.CreateGroupApplicationRequest.profession:type_name -> gw2lfg.Profession
+	11,  // 32: gw2lfg.CreateGroupApplicationRequest.elite_spec:type_name -> gw2lfg.EliteSpec
+	28,  // 33: gw2lfg.CreateGroupApplicationResponse.application:type_name -> gw2lfg.GroupApplication
+	8,   // 34: gw2lfg.UpdateGroupApplicationRequest.role:type_name -> gw2lfg.Role
+	9,   // 35: gw2lfg.UpdateGroupApplicationRequest.boon:type_name -> gw2lfg.Boon
+	10,  // 36: gw2lfg.UpdateGroupApplicationRequest.profession:type_name -> gw2lfg.Profession
+	11,  // 37: gw2lfg.UpdateGroupApplicationRequest.elite_spec:type_name -> gw2lfg.EliteSpec
+	28,  // 38: gw2lfg.UpdateGroupApplicationResponse.application:type_name -> gw2lfg.GroupApplication
+	28,  // 39: gw2lfg.ListGroupApplicationsResponse.applications:type_name -> gw2lfg.GroupApplication
+	28,  // 40: gw2lfg.GroupApplicationUpdate.new_application:type_name -> gw2lfg.GroupApplication
+	28,  // 41: gw2lfg.GroupApplicationUpdate.updated_application:type_name -> gw2lfg.GroupApplication
+	41,  // 42: gw2lfg.GroupApplicationUpdate.new_invitation:type_name -> gw2lfg.Invitation
+	41,  // 43: gw2lfg.InviteToGroupResponse.invitation:type_name -> gw2lfg.Invitation
+	8,   // 44: gw2lfg.RespondToInvitationRequest.role:type_name -> gw2lfg.Role
+	9,   // 45: gw2lfg.RespondToInvitationRequest.boon:type_name -> gw2lfg.Boon
+	10,  // 46: gw2lfg.RespondToInvitationRequest.profession:type_name -> gw2lfg.Profession
+	11,  // 47: gw2lfg.RespondToInvitationRequest.elite_spec:type_name -> gw2lfg.EliteSpec
+	28,  // 48: gw2lfg.RespondToInvitationResponse.application:type_name -> gw2lfg.GroupApplication
+	41,  // 49: gw2lfg.ListInvitationsResponse.invitations:type_name -> gw2lfg.Invitation
+	7,   // 50: gw2lfg.ReviewGroupApplicationRequest.status:type_name -> gw2lfg.ApplicationStatus
+	28,  // 51: gw2lfg.ReviewGroupApplicationResponse.application:type_name -> gw2lfg.GroupApplication
+	13,  // 52: gw2lfg.GroupTemplate.group:type_name -> gw2lfg.CreateGroupRequest
+	55,  // 53: gw2lfg.GroupTemplate.recurrence:type_name -> gw2lfg.Recurrence
+	3,   // 54: gw2lfg.Recurrence.days:type_name -> gw2lfg.Weekday
+	54,  // 55: gw2lfg.CreateGroupTemplateRequest.template:type_name -> gw2lfg.GroupTemplate
+	54,  // 56: gw2lfg.CreateGroupTemplateResponse.template:type_name -> gw2lfg.GroupTemplate
+	54,  // 57: gw2lfg.UpdateGroupTemplateRequest.template:type_name -> gw2lfg.GroupTemplate
+	54,  // 58: gw2lfg.UpdateGroupTemplateResponse.template:type_name -> gw2lfg.GroupTemplate
+	54,  // 59: gw2lfg.ListGroupTemplatesResponse.templates:type_name -> gw2lfg.GroupTemplate
+	72,  // 60: gw2lfg.ListBlockedResponse.blocked:type_name -> gw2lfg.BlockedAccount
+	79,  // 61: gw2lfg.ListWhitelistedResponse.whitelisted:type_name -> gw2lfg.WhitelistedAccount
+	5,   // 62: gw2lfg.Report.target_type:type_name -> gw2lfg.ReportTargetType
+	89,  // 63: gw2lfg.LoginResponse.session:type_name -> gw2lfg.Session
+	89,  // 64: gw2lfg.RefreshSessionResponse.session:type_name -> gw2lfg.Session
+	15,  // 65: gw2lfg.AdminGroup.group:type_name -> gw2lfg.Group
+	28,  // 66: gw2lfg.AdminGroup.applications:type_name -> gw2lfg.GroupApplication
+	90,  // 67: gw2lfg.AdminGroup.creator_ban:type_name -> gw2lfg.Ban
+	91,  // 68: gw2lfg.AdminListGroupsResponse.groups:type_name -> gw2lfg.AdminGroup
+	90,  // 69: gw2lfg.BanAccountResponse.ban:type_name -> gw2lfg.Ban
+	90,  // 70: gw2lfg.ListBansResponse.bans:type_name -> gw2lfg.Ban
+	84,  // 71: gw2lfg.ListReportsResponse.reports:type_name -> gw2lfg.Report
+	84,  // 72: gw2lfg.ResolveReportResponse.reports:type_name -> gw2lfg.Report
+	104, // 73: gw2lfg.ListSubscriptionsResponse.subscriptions:type_name -> gw2lfg.Subscription
+	13,  // 74: gw2lfg.LfgService.CreateGroup:input_type -> gw2lfg.CreateGroupRequest
+	20,  // 75: gw2lfg.LfgService.UpdateGroup:input_type -> gw2lfg.UpdateGroupRequest
+	26,  // 76: gw2lfg.LfgService.ListGroups:input_type -> gw2lfg.ListGroupsRequest
+	18,  // 77: gw2lfg.LfgService.GetGroup:input_type -> gw2lfg.GetGroupRequest
+	22,  // 78: gw2lfg.LfgService.DeleteGroup:input_type -> gw2lfg.DeleteGroupRequest
+	24,  // 79: gw2lfg.LfgService.SubscribeGroups:input_type -> gw2lfg.SubscribeGroupsRequest
+	31,  // 80: gw2lfg.LfgService.CreateGroupApplication:input_type -> gw2lfg.CreateGroupApplicationRequest
+	33,  // 81: gw2lfg.LfgService.UpdateGroupApplication:input_type -> gw2lfg.UpdateGroupApplicationRequest
+	35,  // 82: gw2lfg.LfgService.ListGroupApplications:input_type -> gw2lfg.ListGroupApplicationsRequest
+	37,  // 83: gw2lfg.LfgService.DeleteGroupApplication:input_type -> gw2lfg.DeleteGroupApplicationRequest
+	50,  // 84: gw2lfg.LfgService.ReviewGroupApplication:input_type -> gw2lfg.ReviewGroupApplicationRequest
+	42,  // 85: gw2lfg.LfgService.InviteToGroup:input_type -> gw2lfg.InviteToGroupRequest
+	44,  // 86: gw2lfg.LfgService.RespondToInvitation:input_type -> gw2lfg.RespondToInvitationRequest
+	46,  // 87: gw2lfg.LfgService.CancelInvitation:input_type -> gw2lfg.CancelInvitationRequest
+	48,  // 88: gw2lfg.LfgService.ListInvitations:input_type -> gw2lfg.ListInvitationsRequest
+	39,  // 89: gw2lfg.LfgService.SubscribeGroupApplications:input_type -> gw2lfg.SubscribeGroupApplicationsRequest
+	52,  // 90: gw2lfg.LfgService.Heartbeat:input_type -> gw2lfg.HeartbeatRequest
+	66,  // 91: gw2lfg.LfgService.BlockAccount:input_type -> gw2lfg.BlockAccountRequest
+	68,  // 92: gw2lfg.LfgService.UnblockAccount:input_type -> gw2lfg.UnblockAccountRequest
+	70,  // 93: gw2lfg.LfgService.ListBlocked:input_type -> gw2lfg.ListBlockedRequest
+	73,  // 94: gw2lfg.LfgService.WhitelistAccount:input_type -> gw2lfg.WhitelistAccountRequest
+	75,  // 95: gw2lfg.LfgService.UnwhitelistAccount:input_type -> gw2lfg.UnwhitelistAccountRequest
+	77,  // 96: gw2lfg.LfgService.ListWhitelisted:input_type -> gw2lfg.ListWhitelistedRequest
+	80,  // 97: gw2lfg.LfgService.ReportGroup:input_type -> gw2lfg.ReportGroupRequest
+	82,  // 98: gw2lfg.LfgService.ReportApplication:input_type -> gw2lfg.ReportApplicationRequest
+	56,  // 99: gw2lfg.LfgService.CreateGroupTemplate:input_type -> gw2lfg.CreateGroupTemplateRequest
+	58,  // 100: gw2lfg.LfgService.UpdateGroupTemplate:input_type -> gw2lfg.UpdateGroupTemplateRequest
+	60,  // 101: gw2lfg.LfgService.DeleteGroupTemplate:input_type -> gw2lfg.DeleteGroupTemplateRequest
+	62,  // 102: gw2lfg.LfgService.ListGroupTemplates:input_type -> gw2lfg.ListGroupTemplatesRequest
+	64,  // 103: gw2lfg.LfgService.GetCalendarFeed:input_type -> gw2lfg.GetCalendarFeedRequest
+	85,  // 104: gw2lfg.AuthService.Login:input_type -> gw2lfg.LoginRequest
+	87,  // 105: gw2lfg.AuthService.RefreshSession:input_type -> gw2lfg.RefreshSessionRequest
+	92,  // 106: gw2lfg.AdminService.ListGroups:input_type -> gw2lfg.AdminListGroupsRequest
+	94,  // 107: gw2lfg.AdminService.ForceDeleteGroup:input_type -> gw2lfg.ForceDeleteGroupRequest
+	96,  // 108: gw2lfg.AdminService.ForceDeleteApplication:input_type -> gw2lfg.ForceDeleteApplicationRequest
+	98,  // 109: gw2lfg.AdminService.BanAccount:input_type -> gw2lfg.BanAccountRequest
+	100, // 110: gw2lfg.AdminService.UnbanAccount:input_type -> gw2lfg.UnbanAccountRequest
+	102, // 111: gw2lfg.AdminService.ListBans:input_type -> gw2lfg.ListBansRequest
+	109, // 112: gw2lfg.AdminService.ListSubscriptions:input_type -> gw2lfg.ListSubscriptionsRequest
+	105, // 113: gw2lfg.AdminService.ListReports:input_type -> gw2lfg.ListReportsRequest
+	107, // 114: gw2lfg.AdminService.ResolveReport:input_type -> gw2lfg.ResolveReportRequest
+	14,  // 115: gw2lfg.LfgService.CreateGroup:output_type -> gw2lfg.CreateGroupResponse
+	21,  // 116: gw2lfg.LfgService.UpdateGroup:output_type -> gw2lfg.UpdateGroupResponse
+	27,  // 117: gw2lfg.LfgService.ListGroups:output_type -> gw2lfg.ListGroupsResponse
+	19,  // 118: gw2lfg.LfgService.GetGroup:output_type -> gw2lfg.GetGroupResponse
+	23,  // 119: gw2lfg.LfgService.DeleteGroup:output_type -> gw2lfg.DeleteGroupResponse
+	25,  // 120: gw2lfg.LfgService.SubscribeGroups:output_type -> gw2lfg.GroupsUpdate
+	32,  // 121: gw2lfg.LfgService.CreateGroupApplication:output_type -> gw2lfg.CreateGroupApplicationResponse
+	34,  // 122: gw2lfg.LfgService.UpdateGroupApplication:output_type -> gw2lfg.UpdateGroupApplicationResponse
+	36,  // 123: gw2lfg.LfgService.ListGroupApplications:output_type -> gw2lfg.ListGroupApplicationsResponse
+	38,  // 124: gw2lfg.LfgService.DeleteGroupApplication:output_type -> gw2lfg.DeleteGroupApplicationResponse
+	51,  // 125: gw2lfg.LfgService.ReviewGroupApplication:output_type -> gw2lfg.ReviewGroupApplicationResponse
+	43,  // 126: gw2lfg.LfgService.InviteToGroup:output_type -> gw2lfg.InviteToGroupResponse
+	45,  // 127: gw2lfg.LfgService.RespondToInvitation:output_type -> gw2lfg.RespondToInvitationResponse
+	47,  // 128: gw2lfg.LfgService.CancelInvitation:output_type -> gw2lfg.CancelInvitationResponse
+	49,  // 129: gw2lfg.LfgService.ListInvitations:output_type -> gw2lfg.ListInvitationsResponse
+	40,  // 130: gw2lfg.LfgService.SubscribeGroupApplications:output_type -> gw2lfg.GroupApplicationUpdate
+	53,  // 131: gw2lfg.LfgService.Heartbeat:output_type -> gw2lfg.HeartbeatResponse
+	67,  // 132: gw2lfg.LfgService.BlockAccount:output_type -> gw2lfg.BlockAccountResponse
+	69,  // 133: gw2lfg.LfgService.UnblockAccount:output_type -> gw2lfg.UnblockAccountResponse
+	71,  // 134: gw2lfg.LfgService.ListBlocked:output_type -> gw2lfg.ListBlockedResponse
+	74,  // 135: gw2lfg.LfgService.WhitelistAccount:output_type -> gw2lfg.WhitelistAccountResponse
+	76,  // 136: gw2lfg.LfgService.UnwhitelistAccount:output_type -> gw2lfg.UnwhitelistAccountResponse
+	78,  // 137: gw2lfg.LfgService.ListWhitelisted:output_type -> gw2lfg.ListWhitelistedResponse
+	81,  // 138: gw2lfg.LfgService.ReportGroup:output_type -> gw2lfg.ReportGroupResponse
+	83,  // 139: gw2lfg.LfgService.ReportApplication:output_type -> gw2lfg.ReportApplicationResponse
+	57,  // 140: gw2lfg.LfgService.CreateGroupTemplate:output_type -> gw2lfg.CreateGroupTemplateResponse
+	59,  // 141: gw2lfg.LfgService.UpdateGroupTemplate:output_type -> gw2lfg.UpdateGroupTemplateResponse
+	61,  // 142: gw2lfg.LfgService.DeleteGroupTemplate:output_type -> gw2lfg.DeleteGroupTemplateResponse
+	63,  // 143: gw2lfg.LfgService.ListGroupTemplates:output_type -> gw2lfg.ListGroupTemplatesResponse
+	65,  // 144: gw2lfg.LfgService.GetCalendarFeed:output_type -> gw2lfg.GetCalendarFeedResponse
+	86,  // 145: gw2lfg.AuthService.Login:output_type -> gw2lfg.LoginResponse
+	88,  // 146: gw2lfg.AuthService.RefreshSession:output_type -> gw2lfg.RefreshSessionResponse
+	93,  // 147: gw2lfg.AdminService.ListGroups:output_type -> gw2lfg.AdminListGroupsResponse
+	95,  // 148: gw2lfg.AdminService.ForceDeleteGroup:output_type -> gw2lfg.ForceDeleteGroupResponse
+	97,  // 149: gw2lfg.AdminService.ForceDeleteApplication:output_type -> gw2lfg.ForceDeleteApplicationResponse
+	99,  // 150: gw2lfg.AdminService.BanAccount:output_type -> gw2lfg.BanAccountResponse
+	101, // 151: gw2lfg.AdminService.UnbanAccount:output_type -> gw2lfg.UnbanAccountResponse
+	103, // 152: gw2lfg.AdminService.ListBans:output_type -> gw2lfg.ListBansResponse
+	110, // 153: gw2lfg.AdminService.ListSubscriptions:output_type -> gw2lfg.ListSubscriptionsResponse
+	106, // 154: gw2lfg.AdminService.ListReports:output_type -> gw2lfg.ListReportsResponse
+	108, // 155: gw2lfg.AdminService.ResolveReport:output_type -> gw2lfg.ResolveReportResponse
+	115, // [115:156] is the sub-list for method output_type
+	74,  // [74:115] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -1828,29 +7707,31 @@ func file_service_proto_init() {
 	if File_service_proto != nil {
 		return
 	}
-	file_service_proto_msgTypes[8].OneofWrappers = []any{
+	file_service_proto_msgTypes[12].OneofWrappers = []any{
 		(*GroupsUpdate_NewGroup)(nil),
 		(*GroupsUpdate_UpdatedGroup)(nil),
 		(*GroupsUpdate_RemovedGroupId)(nil),
 	}
-	file_service_proto_msgTypes[17].OneofWrappers = []any{
+	file_service_proto_msgTypes[22].OneofWrappers = []any{
 		(*ListGroupApplicationsRequest_GroupId)(nil),
 		(*ListGroupApplicationsRequest_AccountName)(nil),
 	}
-	file_service_proto_msgTypes[22].OneofWrappers = []any{
+	file_service_proto_msgTypes[27].OneofWrappers = []any{
 		(*GroupApplicationUpdate_NewApplication)(nil),
 		(*GroupApplicationUpdate_UpdatedApplication)(nil),
 		(*GroupApplicationUpdate_RemovedApplicationId)(nil),
+		(*GroupApplicationUpdate_NewInvitation)(nil),
+		(*GroupApplicationUpdate_RemovedInvitationId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      13,
+			NumMessages:   98,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
@@ -22,14 +22,33 @@ const (
 	LfgService_CreateGroup_FullMethodName                = "/gw2lfg.LfgService/CreateGroup"
 	LfgService_UpdateGroup_FullMethodName                = "/gw2lfg.LfgService/UpdateGroup"
 	LfgService_ListGroups_FullMethodName                 = "/gw2lfg.LfgService/ListGroups"
+	LfgService_GetGroup_FullMethodName                   = "/gw2lfg.LfgService/GetGroup"
 	LfgService_DeleteGroup_FullMethodName                = "/gw2lfg.LfgService/DeleteGroup"
 	LfgService_SubscribeGroups_FullMethodName            = "/gw2lfg.LfgService/SubscribeGroups"
 	LfgService_CreateGroupApplication_FullMethodName     = "/gw2lfg.LfgService/CreateGroupApplication"
 	LfgService_UpdateGroupApplication_FullMethodName     = "/gw2lfg.LfgService/UpdateGroupApplication"
 	LfgService_ListGroupApplications_FullMethodName      = "/gw2lfg.LfgService/ListGroupApplications"
 	LfgService_DeleteGroupApplication_FullMethodName     = "/gw2lfg.LfgService/DeleteGroupApplication"
+	LfgService_ReviewGroupApplication_FullMethodName     = "/gw2lfg.LfgService/ReviewGroupApplication"
+	LfgService_InviteToGroup_FullMethodName              = "/gw2lfg.LfgService/InviteToGroup"
+	LfgService_RespondToInvitation_FullMethodName        = "/gw2lfg.LfgService/RespondToInvitation"
+	LfgService_CancelInvitation_FullMethodName           = "/gw2lfg.LfgService/CancelInvitation"
+	LfgService_ListInvitations_FullMethodName            = "/gw2lfg.LfgService/ListInvitations"
 	LfgService_SubscribeGroupApplications_FullMethodName = "/gw2lfg.LfgService/SubscribeGroupApplications"
 	LfgService_Heartbeat_FullMethodName                  = "/gw2lfg.LfgService/Heartbeat"
+	LfgService_BlockAccount_FullMethodName               = "/gw2lfg.LfgService/BlockAccount"
+	LfgService_UnblockAccount_FullMethodName             = "/gw2lfg.LfgService/UnblockAccount"
+	LfgService_ListBlocked_FullMethodName                = "/gw2lfg.LfgService/ListBlocked"
+	LfgService_WhitelistAccount_FullMethodName           = "/gw2lfg.LfgService/WhitelistAccount"
+	LfgService_UnwhitelistAccount_FullMethodName         = "/gw2lfg.LfgService/UnwhitelistAccount"
+	LfgService_ListWhitelisted_FullMethodName            = "/gw2lfg.LfgService/ListWhitelisted"
+	LfgService_ReportGroup_FullMethodName                = "/gw2lfg.LfgService/ReportGroup"
+	LfgService_ReportApplication_FullMethodName          = "/gw2lfg.LfgService/ReportApplication"
+	LfgService_CreateGroupTemplate_FullMethodName        = "/gw2lfg.LfgService/CreateGroupTemplate"
+	LfgService_UpdateGroupTemplate_FullMethodName        = "/gw2lfg.LfgService/UpdateGroupTemplate"
+	LfgService_DeleteGroupTemplate_FullMethodName        = "/gw2lfg.LfgService/DeleteGroupTemplate"
+	LfgService_ListGroupTemplates_FullMethodName         = "/gw2lfg.LfgService/ListGroupTemplates"
+	LfgService_GetCalendarFeed_FullMethodName            = "/gw2lfg.LfgService/GetCalendarFeed"
 )
 
 // LfgServiceClient is the client API for LfgService service.
@@ -39,14 +58,33 @@ type LfgServiceClient interface {
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
 	SubscribeGroups(ctx context.Context, in *SubscribeGroupsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GroupsUpdate], error)
 	CreateGroupApplication(ctx context.Context, in *CreateGroupApplicationRequest, opts ...grpc.CallOption) (*CreateGroupApplicationResponse, error)
 	UpdateGroupApplication(ctx context.Context, in *UpdateGroupApplicationRequest, opts ...grpc.CallOption) (*UpdateGroupApplicationResponse, error)
 	ListGroupApplications(ctx context.Context, in *ListGroupApplicationsRequest, opts ...grpc.CallOption) (*ListGroupApplicationsResponse, error)
 	DeleteGroupApplication(ctx context.Context, in *DeleteGroupApplicationRequest, opts ...grpc.CallOption) (*DeleteGroupApplicationResponse, error)
+	ReviewGroupApplication(ctx context.Context, in *ReviewGroupApplicationRequest, opts ...grpc.CallOption) (*ReviewGroupApplicationResponse, error)
+	InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*InviteToGroupResponse, error)
+	RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*RespondToInvitationResponse, error)
+	CancelInvitation(ctx context.Context, in *CancelInvitationRequest, opts ...grpc.CallOption) (*CancelInvitationResponse, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
 	SubscribeGroupApplications(ctx context.Context, in *SubscribeGroupApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GroupApplicationUpdate], error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	BlockAccount(ctx context.Context, in *BlockAccountRequest, opts ...grpc.CallOption) (*BlockAccountResponse, error)
+	UnblockAccount(ctx context.Context, in *UnblockAccountRequest, opts ...grpc.CallOption) (*UnblockAccountResponse, error)
+	ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+	WhitelistAccount(ctx context.Context, in *WhitelistAccountRequest, opts ...grpc.CallOption) (*WhitelistAccountResponse, error)
+	UnwhitelistAccount(ctx context.Context, in *UnwhitelistAccountRequest, opts ...grpc.CallOption) (*UnwhitelistAccountResponse, error)
+	ListWhitelisted(ctx context.Context, in *ListWhitelistedRequest, opts ...grpc.CallOption) (*ListWhitelistedResponse, error)
+	ReportGroup(ctx context.Context, in *ReportGroupRequest, opts ...grpc.CallOption) (*ReportGroupResponse, error)
+	ReportApplication(ctx context.Context, in *ReportApplicationRequest, opts ...grpc.CallOption) (*ReportApplicationResponse, error)
+	CreateGroupTemplate(ctx context.Context, in *CreateGroupTemplateRequest, opts ...grpc.CallOption) (*CreateGroupTemplateResponse, error)
+	UpdateGroupTemplate(ctx context.Context, in *UpdateGroupTemplateRequest, opts ...grpc.CallOption) (*UpdateGroupTemplateResponse, error)
+	DeleteGroupTemplate(ctx context.Context, in *DeleteGroupTemplateRequest, opts ...grpc.CallOption) (*DeleteGroupTemplateResponse, error)
+	ListGroupTemplates(ctx context.Context, in *ListGroupTemplatesRequest, opts ...grpc.CallOption) (*ListGroupTemplatesResponse, error)
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error)
 }
 
 type lfgServiceClient struct {
@@ -87,6 +125,16 @@ func (c *lfgServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest
 	return out, nil
 }
 
+func (c *lfgServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, LfgService_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lfgServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteGroupResponse)
@@ -156,6 +204,56 @@ func (c *lfgServiceClient) DeleteGroupApplication(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *lfgServiceClient) ReviewGroupApplication(ctx context.Context, in *ReviewGroupApplicationRequest, opts ...grpc.CallOption) (*ReviewGroupApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewGroupApplicationResponse)
+	err := c.cc.Invoke(ctx, LfgService_ReviewGroupApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*InviteToGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteToGroupResponse)
+	err := c.cc.Invoke(ctx, LfgService_InviteToGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*RespondToInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToInvitationResponse)
+	err := c.cc.Invoke(ctx, LfgService_RespondToInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) CancelInvitation(ctx context.Context, in *CancelInvitationRequest, opts ...grpc.CallOption) (*CancelInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelInvitationResponse)
+	err := c.cc.Invoke(ctx, LfgService_CancelInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, LfgService_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lfgServiceClient) SubscribeGroupApplications(ctx context.Context, in *SubscribeGroupApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GroupApplicationUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &LfgService_ServiceDesc.Streams[1], LfgService_SubscribeGroupApplications_FullMethodName, cOpts...)
@@ -185,6 +283,136 @@ func (c *lfgServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest,
 	return out, nil
 }
 
+func (c *lfgServiceClient) BlockAccount(ctx context.Context, in *BlockAccountRequest, opts ...grpc.CallOption) (*BlockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockAccountResponse)
+	err := c.cc.Invoke(ctx, LfgService_BlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) UnblockAccount(ctx context.Context, in *UnblockAccountRequest, opts ...grpc.CallOption) (*UnblockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockAccountResponse)
+	err := c.cc.Invoke(ctx, LfgService_UnblockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedResponse)
+	err := c.cc.Invoke(ctx, LfgService_ListBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) WhitelistAccount(ctx context.Context, in *WhitelistAccountRequest, opts ...grpc.CallOption) (*WhitelistAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhitelistAccountResponse)
+	err := c.cc.Invoke(ctx, LfgService_WhitelistAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) UnwhitelistAccount(ctx context.Context, in *UnwhitelistAccountRequest, opts ...grpc.CallOption) (*UnwhitelistAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwhitelistAccountResponse)
+	err := c.cc.Invoke(ctx, LfgService_UnwhitelistAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ListWhitelisted(ctx context.Context, in *ListWhitelistedRequest, opts ...grpc.CallOption) (*ListWhitelistedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWhitelistedResponse)
+	err := c.cc.Invoke(ctx, LfgService_ListWhitelisted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ReportGroup(ctx context.Context, in *ReportGroupRequest, opts ...grpc.CallOption) (*ReportGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportGroupResponse)
+	err := c.cc.Invoke(ctx, LfgService_ReportGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ReportApplication(ctx context.Context, in *ReportApplicationRequest, opts ...grpc.CallOption) (*ReportApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportApplicationResponse)
+	err := c.cc.Invoke(ctx, LfgService_ReportApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) CreateGroupTemplate(ctx context.Context, in *CreateGroupTemplateRequest, opts ...grpc.CallOption) (*CreateGroupTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupTemplateResponse)
+	err := c.cc.Invoke(ctx, LfgService_CreateGroupTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) UpdateGroupTemplate(ctx context.Context, in *UpdateGroupTemplateRequest, opts ...grpc.CallOption) (*UpdateGroupTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupTemplateResponse)
+	err := c.cc.Invoke(ctx, LfgService_UpdateGroupTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) DeleteGroupTemplate(ctx context.Context, in *DeleteGroupTemplateRequest, opts ...grpc.CallOption) (*DeleteGroupTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupTemplateResponse)
+	err := c.cc.Invoke(ctx, LfgService_DeleteGroupTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) ListGroupTemplates(ctx context.Context, in *ListGroupTemplatesRequest, opts ...grpc.CallOption) (*ListGroupTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupTemplatesResponse)
+	err := c.cc.Invoke(ctx, LfgService_ListGroupTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lfgServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, LfgService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LfgServiceServer is the server API for LfgService service.
 // All implementations must embed UnimplementedLfgServiceServer
 // for forward compatibility.
@@ -192,14 +420,33 @@ type LfgServiceServer interface {
 	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
 	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
 	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
 	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
 	SubscribeGroups(*SubscribeGroupsRequest, grpc.ServerStreamingServer[GroupsUpdate]) error
 	CreateGroupApplication(context.Context, *CreateGroupApplicationRequest) (*CreateGroupApplicationResponse, error)
 	UpdateGroupApplication(context.Context, *UpdateGroupApplicationRequest) (*UpdateGroupApplicationResponse, error)
 	ListGroupApplications(context.Context, *ListGroupApplicationsRequest) (*ListGroupApplicationsResponse, error)
 	DeleteGroupApplication(context.Context, *DeleteGroupApplicationRequest) (*DeleteGroupApplicationResponse, error)
+	ReviewGroupApplication(context.Context, *ReviewGroupApplicationRequest) (*ReviewGroupApplicationResponse, error)
+	InviteToGroup(context.Context, *InviteToGroupRequest) (*InviteToGroupResponse, error)
+	RespondToInvitation(context.Context, *RespondToInvitationRequest) (*RespondToInvitationResponse, error)
+	CancelInvitation(context.Context, *CancelInvitationRequest) (*CancelInvitationResponse, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
 	SubscribeGroupApplications(*SubscribeGroupApplicationsRequest, grpc.ServerStreamingServer[GroupApplicationUpdate]) error
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	BlockAccount(context.Context, *BlockAccountRequest) (*BlockAccountResponse, error)
+	UnblockAccount(context.Context, *UnblockAccountRequest) (*UnblockAccountResponse, error)
+	ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error)
+	WhitelistAccount(context.Context, *WhitelistAccountRequest) (*WhitelistAccountResponse, error)
+	UnwhitelistAccount(context.Context, *UnwhitelistAccountRequest) (*UnwhitelistAccountResponse, error)
+	ListWhitelisted(context.Context, *ListWhitelistedRequest) (*ListWhitelistedResponse, error)
+	ReportGroup(context.Context, *ReportGroupRequest) (*ReportGroupResponse, error)
+	ReportApplication(context.Context, *ReportApplicationRequest) (*ReportApplicationResponse, error)
+	CreateGroupTemplate(context.Context, *CreateGroupTemplateRequest) (*CreateGroupTemplateResponse, error)
+	UpdateGroupTemplate(context.Context, *UpdateGroupTemplateRequest) (*UpdateGroupTemplateResponse, error)
+	DeleteGroupTemplate(context.Context, *DeleteGroupTemplateRequest) (*DeleteGroupTemplateResponse, error)
+	ListGroupTemplates(context.Context, *ListGroupTemplatesRequest) (*ListGroupTemplatesResponse, error)
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
 	mustEmbedUnimplementedLfgServiceServer()
 }
 
@@ -219,6 +466,9 @@ func (UnimplementedLfgServiceServer) UpdateGroup(context.Context, *UpdateGroupRe
 func (UnimplementedLfgServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
+func (UnimplementedLfgServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
 func (UnimplementedLfgServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
@@ -237,12 +487,66 @@ func (UnimplementedLfgServiceServer) ListGroupApplications(context.Context, *Lis
 func (UnimplementedLfgServiceServer) DeleteGroupApplication(context.Context, *DeleteGroupApplicationRequest) (*DeleteGroupApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupApplication not implemented")
 }
+func (UnimplementedLfgServiceServer) ReviewGroupApplication(context.Context, *ReviewGroupApplicationRequest) (*ReviewGroupApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewGroupApplication not implemented")
+}
+func (UnimplementedLfgServiceServer) InviteToGroup(context.Context, *InviteToGroupRequest) (*InviteToGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToGroup not implemented")
+}
+func (UnimplementedLfgServiceServer) RespondToInvitation(context.Context, *RespondToInvitationRequest) (*RespondToInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToInvitation not implemented")
+}
+func (UnimplementedLfgServiceServer) CancelInvitation(context.Context, *CancelInvitationRequest) (*CancelInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvitation not implemented")
+}
+func (UnimplementedLfgServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
 func (UnimplementedLfgServiceServer) SubscribeGroupApplications(*SubscribeGroupApplicationsRequest, grpc.ServerStreamingServer[GroupApplicationUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeGroupApplications not implemented")
 }
 func (UnimplementedLfgServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
+func (UnimplementedLfgServiceServer) BlockAccount(context.Context, *BlockAccountRequest) (*BlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAccount not implemented")
+}
+func (UnimplementedLfgServiceServer) UnblockAccount(context.Context, *UnblockAccountRequest) (*UnblockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAccount not implemented")
+}
+func (UnimplementedLfgServiceServer) ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedLfgServiceServer) WhitelistAccount(context.Context, *WhitelistAccountRequest) (*WhitelistAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistAccount not implemented")
+}
+func (UnimplementedLfgServiceServer) UnwhitelistAccount(context.Context, *UnwhitelistAccountRequest) (*UnwhitelistAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwhitelistAccount not implemented")
+}
+func (UnimplementedLfgServiceServer) ListWhitelisted(context.Context, *ListWhitelistedRequest) (*ListWhitelistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWhitelisted not implemented")
+}
+func (UnimplementedLfgServiceServer) ReportGroup(context.Context, *ReportGroupRequest) (*ReportGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportGroup not implemented")
+}
+func (UnimplementedLfgServiceServer) ReportApplication(context.Context, *ReportApplicationRequest) (*ReportApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportApplication not implemented")
+}
+func (UnimplementedLfgServiceServer) CreateGroupTemplate(context.Context, *CreateGroupTemplateRequest) (*CreateGroupTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupTemplate not implemented")
+}
+func (UnimplementedLfgServiceServer) UpdateGroupTemplate(context.Context, *UpdateGroupTemplateRequest) (*UpdateGroupTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupTemplate not implemented")
+}
+func (UnimplementedLfgServiceServer) DeleteGroupTemplate(context.Context, *DeleteGroupTemplateRequest) (*DeleteGroupTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupTemplate not implemented")
+}
+func (UnimplementedLfgServiceServer) ListGroupTemplates(context.Context, *ListGroupTemplatesRequest) (*ListGroupTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupTemplates not implemented")
+}
+func (UnimplementedLfgServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
 func (UnimplementedLfgServiceServer) mustEmbedUnimplementedLfgServiceServer() {}
 func (UnimplementedLfgServiceServer) testEmbeddedByValue()                    {}
 
@@ -318,6 +622,24 @@ func _LfgService_ListGroups_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LfgService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LfgService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteGroupRequest)
 	if err := dec(in); err != nil {
@@ -419,6 +741,96 @@ func _LfgService_DeleteGroupApplication_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LfgService_ReviewGroupApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewGroupApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ReviewGroupApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ReviewGroupApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ReviewGroupApplication(ctx, req.(*ReviewGroupApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_InviteToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).InviteToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_InviteToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).InviteToGroup(ctx, req.(*InviteToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_RespondToInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).RespondToInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_RespondToInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).RespondToInvitation(ctx, req.(*RespondToInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_CancelInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).CancelInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_CancelInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).CancelInvitation(ctx, req.(*CancelInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LfgService_SubscribeGroupApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeGroupApplicationsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -448,32 +860,270 @@ func _LfgService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-// LfgService_ServiceDesc is the grpc.ServiceDesc for LfgService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LfgService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gw2lfg.LfgService",
-	HandlerType: (*LfgServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGroup",
-			Handler:    _LfgService_CreateGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroup",
-			Handler:    _LfgService_UpdateGroup_Handler,
-		},
-		{
-			MethodName: "ListGroups",
-			Handler:    _LfgService_ListGroups_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _LfgService_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "CreateGroupApplication",
-			Handler:    _LfgService_CreateGroupApplication_Handler,
+func _LfgService_BlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).BlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_BlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).BlockAccount(ctx, req.(*BlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_UnblockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).UnblockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_UnblockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).UnblockAccount(ctx, req.(*UnblockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ListBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ListBlocked(ctx, req.(*ListBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_WhitelistAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).WhitelistAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_WhitelistAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).WhitelistAccount(ctx, req.(*WhitelistAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_UnwhitelistAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwhitelistAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).UnwhitelistAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_UnwhitelistAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).UnwhitelistAccount(ctx, req.(*UnwhitelistAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ListWhitelisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWhitelistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ListWhitelisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ListWhitelisted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ListWhitelisted(ctx, req.(*ListWhitelistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ReportGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ReportGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ReportGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ReportGroup(ctx, req.(*ReportGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ReportApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ReportApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ReportApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ReportApplication(ctx, req.(*ReportApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_CreateGroupTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).CreateGroupTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_CreateGroupTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).CreateGroupTemplate(ctx, req.(*CreateGroupTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_UpdateGroupTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).UpdateGroupTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_UpdateGroupTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).UpdateGroupTemplate(ctx, req.(*UpdateGroupTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_DeleteGroupTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).DeleteGroupTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_DeleteGroupTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).DeleteGroupTemplate(ctx, req.(*DeleteGroupTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_ListGroupTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).ListGroupTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_ListGroupTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).ListGroupTemplates(ctx, req.(*ListGroupTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LfgService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LfgServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LfgService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LfgServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LfgService_ServiceDesc is the grpc.ServiceDesc for LfgService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LfgService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gw2lfg.LfgService",
+	HandlerType: (*LfgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _LfgService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _LfgService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _LfgService_ListGroups_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _LfgService_GetGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _LfgService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "CreateGroupApplication",
+			Handler:    _LfgService_CreateGroupApplication_Handler,
 		},
 		{
 			MethodName: "UpdateGroupApplication",
@@ -487,10 +1137,82 @@ var LfgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGroupApplication",
 			Handler:    _LfgService_DeleteGroupApplication_Handler,
 		},
+		{
+			MethodName: "ReviewGroupApplication",
+			Handler:    _LfgService_ReviewGroupApplication_Handler,
+		},
+		{
+			MethodName: "InviteToGroup",
+			Handler:    _LfgService_InviteToGroup_Handler,
+		},
+		{
+			MethodName: "RespondToInvitation",
+			Handler:    _LfgService_RespondToInvitation_Handler,
+		},
+		{
+			MethodName: "CancelInvitation",
+			Handler:    _LfgService_CancelInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _LfgService_ListInvitations_Handler,
+		},
 		{
 			MethodName: "Heartbeat",
 			Handler:    _LfgService_Heartbeat_Handler,
 		},
+		{
+			MethodName: "BlockAccount",
+			Handler:    _LfgService_BlockAccount_Handler,
+		},
+		{
+			MethodName: "UnblockAccount",
+			Handler:    _LfgService_UnblockAccount_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _LfgService_ListBlocked_Handler,
+		},
+		{
+			MethodName: "WhitelistAccount",
+			Handler:    _LfgService_WhitelistAccount_Handler,
+		},
+		{
+			MethodName: "UnwhitelistAccount",
+			Handler:    _LfgService_UnwhitelistAccount_Handler,
+		},
+		{
+			MethodName: "ListWhitelisted",
+			Handler:    _LfgService_ListWhitelisted_Handler,
+		},
+		{
+			MethodName: "ReportGroup",
+			Handler:    _LfgService_ReportGroup_Handler,
+		},
+		{
+			MethodName: "ReportApplication",
+			Handler:    _LfgService_ReportApplication_Handler,
+		},
+		{
+			MethodName: "CreateGroupTemplate",
+			Handler:    _LfgService_CreateGroupTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateGroupTemplate",
+			Handler:    _LfgService_UpdateGroupTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteGroupTemplate",
+			Handler:    _LfgService_DeleteGroupTemplate_Handler,
+		},
+		{
+			MethodName: "ListGroupTemplates",
+			Handler:    _LfgService_ListGroupTemplates_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _LfgService_GetCalendarFeed_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -506,3 +1228,549 @@ var LfgService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "service.proto",
 }
+
+const (
+	AuthService_Login_FullMethodName          = "/gw2lfg.AuthService/Login"
+	AuthService_RefreshSession_FullMethodName = "/gw2lfg.AuthService/RefreshSession"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+type AuthServiceServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gw2lfg.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _AuthService_RefreshSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	AdminService_ListGroups_FullMethodName             = "/gw2lfg.AdminService/ListGroups"
+	AdminService_ForceDeleteGroup_FullMethodName       = "/gw2lfg.AdminService/ForceDeleteGroup"
+	AdminService_ForceDeleteApplication_FullMethodName = "/gw2lfg.AdminService/ForceDeleteApplication"
+	AdminService_BanAccount_FullMethodName             = "/gw2lfg.AdminService/BanAccount"
+	AdminService_UnbanAccount_FullMethodName           = "/gw2lfg.AdminService/UnbanAccount"
+	AdminService_ListBans_FullMethodName               = "/gw2lfg.AdminService/ListBans"
+	AdminService_ListSubscriptions_FullMethodName      = "/gw2lfg.AdminService/ListSubscriptions"
+	AdminService_ListReports_FullMethodName            = "/gw2lfg.AdminService/ListReports"
+	AdminService_ResolveReport_FullMethodName          = "/gw2lfg.AdminService/ResolveReport"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	ListGroups(ctx context.Context, in *AdminListGroupsRequest, opts ...grpc.CallOption) (*AdminListGroupsResponse, error)
+	ForceDeleteGroup(ctx context.Context, in *ForceDeleteGroupRequest, opts ...grpc.CallOption) (*ForceDeleteGroupResponse, error)
+	ForceDeleteApplication(ctx context.Context, in *ForceDeleteApplicationRequest, opts ...grpc.CallOption) (*ForceDeleteApplicationResponse, error)
+	BanAccount(ctx context.Context, in *BanAccountRequest, opts ...grpc.CallOption) (*BanAccountResponse, error)
+	UnbanAccount(ctx context.Context, in *UnbanAccountRequest, opts ...grpc.CallOption) (*UnbanAccountResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListGroups(ctx context.Context, in *AdminListGroupsRequest, opts ...grpc.CallOption) (*AdminListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListGroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceDeleteGroup(ctx context.Context, in *ForceDeleteGroupRequest, opts ...grpc.CallOption) (*ForceDeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceDeleteGroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForceDeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceDeleteApplication(ctx context.Context, in *ForceDeleteApplicationRequest, opts ...grpc.CallOption) (*ForceDeleteApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceDeleteApplicationResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForceDeleteApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BanAccount(ctx context.Context, in *BanAccountRequest, opts ...grpc.CallOption) (*BanAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_BanAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnbanAccount(ctx context.Context, in *UnbanAccountRequest, opts ...grpc.CallOption) (*UnbanAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbanAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnbanAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	ListGroups(context.Context, *AdminListGroupsRequest) (*AdminListGroupsResponse, error)
+	ForceDeleteGroup(context.Context, *ForceDeleteGroupRequest) (*ForceDeleteGroupResponse, error)
+	ForceDeleteApplication(context.Context, *ForceDeleteApplicationRequest) (*ForceDeleteApplicationResponse, error)
+	BanAccount(context.Context, *BanAccountRequest) (*BanAccountResponse, error)
+	UnbanAccount(context.Context, *UnbanAccountRequest) (*UnbanAccountResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListGroups(context.Context, *AdminListGroupsRequest) (*AdminListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedAdminServiceServer) ForceDeleteGroup(context.Context, *ForceDeleteGroupRequest) (*ForceDeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeleteGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ForceDeleteApplication(context.Context, *ForceDeleteApplicationRequest) (*ForceDeleteApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeleteApplication not implemented")
+}
+func (UnimplementedAdminServiceServer) BanAccount(context.Context, *BanAccountRequest) (*BanAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) UnbanAccount(context.Context, *UnbanAccountRequest) (*UnbanAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedAdminServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedAdminServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListGroups(ctx, req.(*AdminListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceDeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceDeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForceDeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceDeleteGroup(ctx, req.(*ForceDeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceDeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDeleteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceDeleteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForceDeleteApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceDeleteApplication(ctx, req.(*ForceDeleteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BanAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BanAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BanAccount(ctx, req.(*BanAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnbanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnbanAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnbanAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnbanAccount(ctx, req.(*UnbanAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gw2lfg.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGroups",
+			Handler:    _AdminService_ListGroups_Handler,
+		},
+		{
+			MethodName: "ForceDeleteGroup",
+			Handler:    _AdminService_ForceDeleteGroup_Handler,
+		},
+		{
+			MethodName: "ForceDeleteApplication",
+			Handler:    _AdminService_ForceDeleteApplication_Handler,
+		},
+		{
+			MethodName: "BanAccount",
+			Handler:    _AdminService_BanAccount_Handler,
+		},
+		{
+			MethodName: "UnbanAccount",
+			Handler:    _AdminService_UnbanAccount_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _AdminService_ListBans_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _AdminService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _AdminService_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _AdminService_ResolveReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Handler limits plain HTTP requests to next by client address. They draw
+// from the bucket of method, which needn't be a gRPC method, e.g. a path
+// prefix listed in Config.Methods.
+func (rl *RateLimiter) Handler(method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Present the request the way peerAddr expects a gRPC call
+		ctx := r.Context()
+		if addrPort, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: net.TCPAddrFromAddrPort(addrPort)})
+		}
+		if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": forwarded})
+		}
+		addr, ok := rl.peerAddr(ctx)
+		if !ok {
+			http.Error(w, "missing client address", http.StatusBadRequest)
+			return
+		}
+
+		bucket, limit, cost := rl.limitFor(method)
+		now := rl.now()
+		limiter := rl.getLimiter("peer:"+addr.String()+"|"+bucket, limit, now)
+		reservation := limiter.ReserveN(now, cost)
+		if !reservation.OK() {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
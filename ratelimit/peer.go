@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerAddr returns the address of the client. Behind a trusted proxy this
+// is the rightmost X-Forwarded-For entry that isn't a trusted proxy itself,
+// since everything left of it could have been made up by the client.
+func (rl *RateLimiter) peerAddr(ctx context.Context) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	addr = addr.Unmap()
+	if !rl.isTrusted(addr) {
+		return addr, true
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := strings.Split(strings.Join(md.Get("x-forwarded-for"), ","), ",")
+	for _, entry := range slices.Backward(forwarded) {
+		hop, err := netip.ParseAddr(strings.TrimSpace(entry))
+		if err != nil {
+			// Garbage in the header, stop at the last address we trust
+			break
+		}
+		addr = hop.Unmap()
+		if !rl.isTrusted(addr) {
+			break
+		}
+	}
+	return addr, true
+}
+
+func (rl *RateLimiter) isTrusted(addr netip.Addr) bool {
+	for _, prefix := range rl.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePrefixes parses a comma separated list of CIDR prefixes or single
+// addresses.
+func ParsePrefixes(s string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// LimitFailures wraps authFunc so that failed authentications draw from
+// rl, and callers that exhausted it are rejected before authFunc runs.
+// This keeps clients spraying random tokens from causing a GW2 API lookup
+// for each of them.
+func (rl *RateLimiter) LimitFailures(authFunc grpc_auth.AuthFunc) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		clientID, ok := rl.clientID(ctx)
+		if !ok {
+			return authFunc(ctx)
+		}
+		key := clientID + "|"
+		now := rl.now()
+
+		rl.mu.RLock()
+		b, exists := rl.limiters[key]
+		rl.mu.RUnlock()
+		if exists {
+			if tokens := b.limiter.TokensAt(now); tokens < 1 {
+				delay := time.Duration(math.Ceil((1 - tokens) / float64(b.limiter.Limit()) * float64(time.Second)))
+				return nil, rejection(ctx, "failed_auth", rl.defaults, 1, delay)
+			}
+		}
+
+		newCtx, err := authFunc(ctx)
+		if status.Code(err) == codes.Unauthenticated {
+			rl.getLimiter(key, rl.defaults, now).AllowN(now, 1)
+		}
+		return newCtx, err
+	}
+}
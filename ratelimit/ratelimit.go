@@ -2,96 +2,329 @@ package ratelimit
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"gw2lfgserver/clientinfo"
+	"math"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Metadata keys set by Limit. Remaining quota goes into the headers of
+// successful calls, retry-after into the trailers of rejected ones.
+const (
+	HeaderLimit       = "x-ratelimit-limit"
+	HeaderRemaining   = "x-ratelimit-remaining"
+	TrailerRetryAfter = "retry-after"
 )
 
 const ClientIDKey = "client-id"
 
 // RateLimiter manages rate limits for different clients
 type RateLimiter struct {
-	limiters   map[string]*rate.Limiter
-	mu         sync.RWMutex
-	rate       rate.Limit
-	burst      int
-	cleanupInt time.Duration
+	limiters    map[string]*bucket
+	mu          sync.RWMutex
+	defaults    Limit
+	methods     map[string]Limit
+	cleanupInt  time.Duration
+	idleTimeout time.Duration
+	now         func() time.Time
+	evictions   atomic.Uint64
+	stop        chan struct{}
+	stopOnce    sync.Once
+	done        chan struct{}
+	byPeer      bool
+	trusted     []netip.Prefix
+	hideQuota   bool
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	// lastUsed is the unix nano time of the last call, updated without
+	// taking the write lock
+	lastUsed atomic.Int64
+}
+
+// Stats describes the buckets held by the limiter.
+type Stats struct {
+	Buckets   int
+	Evictions uint64
+}
+
+// Limit configures a token bucket. A method limit without its own rate
+// shares the default bucket, and only changes the cost of a call.
+type Limit struct {
+	RequestsPerSecond float64 `json:"requests_per_second,omitempty"`
+	Burst             int     `json:"burst,omitempty"`
+	// Cost is the number of tokens a call takes, 1 if unset.
+	Cost int `json:"cost,omitempty"`
 }
 
 // Config holds configuration for the rate limiter
 type Config struct {
-	RequestsPerSecond float64
-	Burst             int
-	CleanupInterval   time.Duration
+	Default Limit `json:"default"`
+	// Methods maps full method names ("/package.Service/Method") or whole
+	// services ("/package.Service/") to their limit.
+	Methods         map[string]Limit `json:"methods"`
+	CleanupInterval time.Duration    `json:"-"`
+	// IdleTimeout is how long a bucket must be unused before it is evicted,
+	// CleanupInterval if unset. Buckets are only evicted once refilled, so
+	// eviction never hands out extra tokens.
+	IdleTimeout time.Duration `json:"-"`
+	// Now replaces time.Now if set.
+	Now func() time.Time `json:"-"`
+	// ByPeer keys buckets by peer address even for authenticated calls.
+	ByPeer bool `json:"-"`
+	// TrustedProxies are the addresses of reverse proxies whose
+	// X-Forwarded-For header is believed.
+	TrustedProxies []netip.Prefix `json:"-"`
+	// HideQuota leaves out the remaining quota headers, for limiters that
+	// run alongside the one whose quota clients should see.
+	HideQuota bool `json:"-"`
+}
+
+// LoadConfig reads the default and per-method limits from a JSON file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if cfg.Default.RequestsPerSecond <= 0 || cfg.Default.Burst <= 0 {
+		return cfg, fmt.Errorf("%s: default limit needs requests_per_second and burst", path)
+	}
+	for method, limit := range cfg.Methods {
+		if limit.RequestsPerSecond < 0 || limit.Burst < 0 || limit.Cost < 0 {
+			return cfg, fmt.Errorf("%s: negative limit for %s", path, method)
+		}
+		// A call costing more than the bucket holds could never succeed
+		burst := cfg.Default.Burst
+		if limit.RequestsPerSecond > 0 {
+			burst = max(limit.Burst, 1)
+		}
+		if limit.Cost > burst {
+			return cfg, fmt.Errorf("%s: cost of %s exceeds its burst of %d", path, method, burst)
+		}
+	}
+	return cfg, nil
 }
 
 // NewRateLimiter creates a new rate limiter with the given configuration
 func NewRateLimiter(cfg Config) *RateLimiter {
 	rl := &RateLimiter{
-		limiters:   make(map[string]*rate.Limiter),
-		rate:       rate.Limit(cfg.RequestsPerSecond),
-		burst:      cfg.Burst,
-		cleanupInt: cfg.CleanupInterval,
+		limiters:    make(map[string]*bucket),
+		defaults:    cfg.Default,
+		methods:     cfg.Methods,
+		cleanupInt:  cfg.CleanupInterval,
+		idleTimeout: cfg.IdleTimeout,
+		now:         cfg.Now,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+		byPeer:      cfg.ByPeer,
+		trusted:     cfg.TrustedProxies,
+		hideQuota:   cfg.HideQuota,
+	}
+	if rl.idleTimeout <= 0 {
+		rl.idleTimeout = cfg.CleanupInterval
+	}
+	if rl.now == nil {
+		rl.now = time.Now
 	}
 
 	if cfg.CleanupInterval > 0 {
 		go rl.cleanup()
+	} else {
+		close(rl.done)
 	}
 
 	return rl
 }
 
+// Close stops the cleanup goroutine and waits for it to exit.
+func (rl *RateLimiter) Close() error {
+	rl.stopOnce.Do(func() { close(rl.stop) })
+	<-rl.done
+	return nil
+}
+
+// Stats returns the number of live buckets and evictions since startup.
+func (rl *RateLimiter) Stats() Stats {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return Stats{
+		Buckets:   len(rl.limiters),
+		Evictions: rl.evictions.Load(),
+	}
+}
+
 func (rl *RateLimiter) Limit(ctx context.Context) error {
-	clientInfo := clientinfo.FromContext(ctx)
-	if clientInfo == nil {
+	clientID, ok := rl.clientID(ctx)
+	if !ok {
 		return status.Errorf(codes.InvalidArgument, "missing client ID")
 	}
 
-	limiter := rl.getLimiter(clientInfo.AccountName)
-	if !limiter.Allow() {
-		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for client %s", clientInfo.AccountName)
+	method, _ := grpc.Method(ctx)
+	bucket, limit, cost := rl.limitFor(method)
+	now := rl.now()
+	limiter := rl.getLimiter(clientID+"|"+bucket, limit, now)
+
+	reservation := limiter.ReserveN(now, cost)
+	if !reservation.OK() {
+		return rejection(ctx, bucket, limit, cost, 0)
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// Give the tokens back, the call isn't going to wait for them
+		reservation.CancelAt(now)
+		return rejection(ctx, bucket, limit, cost, delay)
 	}
 
+	if rl.hideQuota {
+		return nil
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(
+		HeaderLimit, strconv.Itoa(limit.Burst),
+		HeaderRemaining, strconv.Itoa(int(limiter.TokensAt(now))),
+	))
 	return nil
 }
 
-// Helper methods remain the same as before...
-func (rl *RateLimiter) getLimiter(clientID string) *rate.Limiter {
-	rl.mu.RLock()
-	limiter, exists := rl.limiters[clientID]
-	rl.mu.RUnlock()
+// rejection builds a ResourceExhausted status telling the client when to
+// retry. A zero delay means the call can never succeed because it costs
+// more than the bucket holds.
+func rejection(ctx context.Context, bucket string, limit Limit, cost int, delay time.Duration) error {
+	if bucket == "" {
+		bucket = "default"
+	}
+	violation := &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     bucket,
+			Description: fmt.Sprintf("%g requests per second with a burst of %d, this call costs %d", limit.RequestsPerSecond, limit.Burst, cost),
+		}},
+	}
+	if delay <= 0 {
+		st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(violation)
+		if err != nil {
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return st.Err()
+	}
+
+	// Whole seconds, as in the HTTP header of the same name
+	retryAfter := int(math.Ceil(delay.Seconds()))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(TrailerRetryAfter, strconv.Itoa(retryAfter)))
 
-	if exists {
-		return limiter
+	st, err := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded, retry in %s", delay.Round(time.Millisecond))).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}, violation)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
 	}
+	return st.Err()
+}
 
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+// limitFor returns the bucket a method draws from, the limit of that bucket
+// and the cost of a call. Methods without their own rate use the default
+// bucket, named "".
+func (rl *RateLimiter) limitFor(method string) (string, Limit, int) {
+	bucket := method
+	limit, ok := rl.methods[method]
+	if !ok {
+		if i := strings.LastIndexByte(method, '/'); i >= 0 {
+			bucket = method[:i+1]
+			limit, ok = rl.methods[bucket]
+		}
+	}
 
-	if limiter, exists = rl.limiters[clientID]; exists {
-		return limiter
+	cost := 1
+	if ok && limit.Cost > 0 {
+		cost = limit.Cost
+	}
+	if !ok || limit.RequestsPerSecond <= 0 {
+		return "", rl.defaults, cost
 	}
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+	return bucket, limit, cost
+}
 
-	limiter = rate.NewLimiter(rl.rate, rl.burst)
-	rl.limiters[clientID] = limiter
-	return limiter
+// clientID identifies the caller by account name, or by peer address for
+// unauthenticated methods such as Login and for limiters keyed by peer.
+func (rl *RateLimiter) clientID(ctx context.Context) (string, bool) {
+	if !rl.byPeer {
+		if clientInfo := clientinfo.FromContext(ctx); clientInfo != nil {
+			return clientInfo.AccountName, true
+		}
+	}
+	if addr, ok := rl.peerAddr(ctx); ok {
+		return "peer:" + addr.String(), true
+	}
+	return "", false
+}
+
+func (rl *RateLimiter) getLimiter(key string, limit Limit, now time.Time) *rate.Limiter {
+	rl.mu.RLock()
+	b, exists := rl.limiters[key]
+	rl.mu.RUnlock()
+
+	if !exists {
+		rl.mu.Lock()
+		if b, exists = rl.limiters[key]; !exists {
+			b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)}
+			rl.limiters[key] = b
+		}
+		rl.mu.Unlock()
+	}
+
+	b.lastUsed.Store(now.UnixNano())
+	return b.limiter
 }
 
 func (rl *RateLimiter) cleanup() {
+	defer close(rl.done)
 	ticker := time.NewTicker(rl.cleanupInt)
 	defer ticker.Stop()
 
-	// for range ticker.C {
-	// 	rl.mu.Lock()
-	// 	for clientID, limiter := range rl.limiters {
-	// 		if time.Since(limiter.LastTime()) > rl.cleanupInt {
-	// 			delete(rl.limiters, clientID)
-	// 		}
-	// 	}
-	// 	rl.mu.Unlock()
-	// }
+	for {
+		select {
+		case <-rl.stop:
+			return
+		case <-ticker.C:
+			rl.evictIdle(rl.now())
+		}
+	}
+}
+
+// evictIdle removes buckets that have been idle for the idle timeout and
+// are full again, so a recreated bucket is no different from the old one.
+func (rl *RateLimiter) evictIdle(now time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	idleSince := now.Add(-rl.idleTimeout).UnixNano()
+	for key, b := range rl.limiters {
+		if b.lastUsed.Load() > idleSince {
+			continue
+		}
+		if b.limiter.TokensAt(now) < float64(b.limiter.Burst()) {
+			continue
+		}
+		delete(rl.limiters, key)
+		rl.evictions.Add(1)
+	}
 }
@@ -0,0 +1,162 @@
+package ratelimit
+
+import (
+	"context"
+	"gw2lfgserver/clientinfo"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Config.Now that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func accountContext(accountName string) context.Context {
+	return clientinfo.ToContext(context.Background(), &clientinfo.ClientInfo{
+		AccountName: accountName,
+		TokenHash:   accountName,
+	})
+}
+
+func call(t *testing.T, rl *RateLimiter, accountName string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if err := rl.Limit(accountContext(accountName)); err != nil {
+			t.Fatalf("Limit(%s) call %d: %v", accountName, i+1, err)
+		}
+	}
+}
+
+func TestEvictIdle(t *testing.T) {
+	const idleTimeout = time.Minute
+
+	tests := []struct {
+		name        string
+		limit       Limit
+		calls       int
+		idle        time.Duration
+		wantBuckets int
+	}{
+		{
+			name:        "full bucket idle past timeout is evicted",
+			limit:       Limit{RequestsPerSecond: 1, Burst: 5},
+			calls:       3,
+			idle:        2 * idleTimeout,
+			wantBuckets: 0,
+		},
+		{
+			name:        "partly drained bucket is kept",
+			limit:       Limit{RequestsPerSecond: 0.01, Burst: 5},
+			calls:       3,
+			idle:        2 * idleTimeout,
+			wantBuckets: 1,
+		},
+		{
+			name:        "bucket used within timeout is kept",
+			limit:       Limit{RequestsPerSecond: 1, Burst: 5},
+			calls:       1,
+			idle:        idleTimeout / 2,
+			wantBuckets: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newFakeClock()
+			rl := NewRateLimiter(Config{Default: tt.limit, IdleTimeout: idleTimeout, Now: clock.Now})
+			defer rl.Close()
+
+			call(t, rl, "player.1234", tt.calls)
+			clock.Advance(tt.idle)
+			rl.evictIdle(clock.Now())
+
+			if got := rl.Stats().Buckets; got != tt.wantBuckets {
+				t.Errorf("Buckets = %d, want %d", got, tt.wantBuckets)
+			}
+		})
+	}
+}
+
+func TestStatsCountsEvictions(t *testing.T) {
+	clock := newFakeClock()
+	rl := NewRateLimiter(Config{
+		Default:     Limit{RequestsPerSecond: 1, Burst: 5},
+		IdleTimeout: time.Minute,
+		Now:         clock.Now,
+	})
+	defer rl.Close()
+
+	call(t, rl, "idle.1234", 1)
+	clock.Advance(2 * time.Minute)
+	call(t, rl, "active.1234", 1)
+	rl.evictIdle(clock.Now())
+
+	want := Stats{Buckets: 1, Evictions: 1}
+	if got := rl.Stats(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+}
+
+func TestCleanupEvictsAndCloseStops(t *testing.T) {
+	clock := newFakeClock()
+	rl := NewRateLimiter(Config{
+		Default:         Limit{RequestsPerSecond: 1, Burst: 5},
+		CleanupInterval: time.Millisecond,
+		IdleTimeout:     time.Minute,
+		Now:             clock.Now,
+	})
+
+	call(t, rl, "player.1234", 1)
+	clock.Advance(2 * time.Minute)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for rl.Stats().Evictions == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("cleanup goroutine never evicted the idle bucket")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		rl.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return")
+	}
+	select {
+	case <-rl.done:
+	default:
+		t.Fatal("cleanup goroutine still running after Close")
+	}
+	// Closing twice must not panic or block
+	rl.Close()
+
+	call(t, rl, "player.1234", 1)
+	clock.Advance(2 * time.Minute)
+	time.Sleep(20 * time.Millisecond)
+	if got := rl.Stats(); got.Buckets != 1 || got.Evictions != 1 {
+		t.Errorf("Stats after Close = %+v, want the bucket kept", got)
+	}
+}
@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamConfig holds configuration for the stream limiter
+type StreamConfig struct {
+	// MaxStreams caps the streams a client may have open at once.
+	MaxStreams int
+	// Opens limits how often a client may open a stream, so reconnect
+	// loops can't churn through subscriptions.
+	Opens           Limit
+	CleanupInterval time.Duration
+}
+
+// StreamLimiter limits the streams held open by each client across all of
+// its connections, unlike grpc.MaxConcurrentStreams which is per connection.
+type StreamLimiter struct {
+	maxStreams int
+	opens      *RateLimiter
+	mu         sync.Mutex
+	open       map[string]int
+}
+
+// StreamStats describes the streams tracked by the limiter.
+type StreamStats struct {
+	Clients int
+	Streams int
+}
+
+func NewStreamLimiter(cfg StreamConfig) *StreamLimiter {
+	return &StreamLimiter{
+		maxStreams: cfg.MaxStreams,
+		opens: NewRateLimiter(Config{
+			Default:         cfg.Opens,
+			CleanupInterval: cfg.CleanupInterval,
+			HideQuota:       true,
+		}),
+		open: make(map[string]int),
+	}
+}
+
+// StreamServerInterceptor enforces the limits. It must run after
+// authentication so streams are counted per account.
+func (sl *StreamLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		clientID, ok := sl.opens.clientID(ctx)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "missing client ID")
+		}
+		if err := sl.opens.Limit(ctx); err != nil {
+			return err
+		}
+		if !sl.acquire(clientID) {
+			return status.Errorf(codes.ResourceExhausted, "too many open streams, at most %d are allowed", sl.maxStreams)
+		}
+		defer sl.release(clientID)
+
+		return handler(srv, ss)
+	}
+}
+
+func (sl *StreamLimiter) acquire(clientID string) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if sl.maxStreams > 0 && sl.open[clientID] >= sl.maxStreams {
+		return false
+	}
+	sl.open[clientID]++
+	return true
+}
+
+func (sl *StreamLimiter) release(clientID string) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if sl.open[clientID]--; sl.open[clientID] <= 0 {
+		delete(sl.open, clientID)
+	}
+}
+
+// Stats returns the number of clients with open streams and the total
+// number of open streams.
+func (sl *StreamLimiter) Stats() StreamStats {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	stats := StreamStats{Clients: len(sl.open)}
+	for _, n := range sl.open {
+		stats.Streams += n
+	}
+	return stats
+}
+
+// Close stops the cleanup of the open rate buckets.
+func (sl *StreamLimiter) Close() error {
+	return sl.opens.Close()
+}
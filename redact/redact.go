@@ -0,0 +1,122 @@
+// Package redact scrubs credentials from log output and error messages.
+package redact
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const placeholder = "[REDACTED]"
+
+var patterns = []*regexp.Regexp{
+	// Authorization headers and similar "Bearer <token>" strings.
+	regexp.MustCompile(`(?i)(bearer\s+)[^\s"',;]+`),
+	// Query string credentials.
+	regexp.MustCompile(`(?i)(access_token=)[^&\s"']+`),
+	// GW2 API keys.
+	regexp.MustCompile(`(?i)()[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{20}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`),
+	// GW2 subtokens are JWTs.
+	regexp.MustCompile(`()eyJ[\w-]+\.[\w-]+\.[\w-]+`),
+	// Our own session tokens.
+	regexp.MustCompile(`()lfg1\.[\w-]+\.[\w-]+`),
+}
+
+// String replaces all credentials found in s.
+func String(s string) string {
+	for _, p := range patterns {
+		s = p.ReplaceAllString(s, "${1}"+placeholder)
+	}
+	return s
+}
+
+// Handler is a slog.Handler that redacts credentials from the message and
+// all attributes before passing the record on.
+type Handler struct {
+	next slog.Handler
+}
+
+func NewHandler(next slog.Handler) *Handler {
+	return &Handler{next: next}
+}
+
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	redacted := slog.NewRecord(r.Time, r.Level, String(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(attr(a))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = attr(a)
+	}
+	return &Handler{next: h.next.WithAttrs(redacted)}
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name)}
+}
+
+func attr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, String(v.String()))
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]any, len(group))
+		for i, ga := range group {
+			redacted[i] = attr(ga)
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		// Only stringify values that actually contain a credential, so
+		// structured values keep their type in the output.
+		s := fmt.Sprint(v.Any())
+		if r := String(s); r != s {
+			return slog.String(a.Key, r)
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// UnaryServerInterceptor redacts credentials from returned error messages.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, redactError(err)
+	}
+}
+
+// StreamServerInterceptor redacts credentials from returned error messages.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return redactError(handler(srv, ss))
+	}
+}
+
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, _ := status.FromError(err)
+	msg := String(st.Message())
+	if msg == st.Message() {
+		return err
+	}
+	p := st.Proto()
+	p.Message = msg
+	return status.FromProto(p).Err()
+}
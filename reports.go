@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"gw2lfgserver/contentpolicy"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Server) ReportGroup(ctx context.Context, req *pb.ReportGroupRequest) (*pb.ReportGroupResponse, error) {
+	client := mustGetClient(ctx)
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required")
+	}
+
+	group, err := s.db.GetGroup(ctx, req.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get group")
+	}
+	if group == nil || !canView(group, client, req.InviteCode) {
+		return nil, status.Error(codes.NotFound, "Group not found")
+	}
+	if group.CreatorId == client.AccountName {
+		return nil, status.Error(codes.InvalidArgument, "Cannot report own group")
+	}
+
+	if err := s.saveReport(ctx, &pb.Report{
+		Reporter:          client.AccountName,
+		TargetType:        pb.ReportTargetType_REPORT_TARGET_GROUP,
+		TargetId:          group.Id,
+		TargetAccountName: group.CreatorId,
+		Reason:            req.Reason,
+	}, groupForClient(group, client)); err != nil {
+		return nil, err
+	}
+
+	s.hideReportedGroup(ctx, group)
+
+	return &pb.ReportGroupResponse{}, nil
+}
+
+func (s *Server) ReportApplication(ctx context.Context, req *pb.ReportApplicationRequest) (*pb.ReportApplicationResponse, error) {
+	client := mustGetClient(ctx)
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required")
+	}
+
+	application, err := s.db.GetApplication(ctx, req.ApplicationId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get application")
+	}
+	if application == nil {
+		return nil, status.Error(codes.NotFound, "Application not found")
+	}
+	// Only the commander gets to see applications to their group
+	group, err := s.db.GetGroup(ctx, application.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get group")
+	}
+	if group == nil || group.CreatorId != client.AccountName {
+		return nil, status.Error(codes.PermissionDenied, "Not group creator")
+	}
+
+	if err := s.saveReport(ctx, &pb.Report{
+		Reporter:          client.AccountName,
+		TargetType:        pb.ReportTargetType_REPORT_TARGET_APPLICATION,
+		TargetId:          application.Id,
+		TargetAccountName: application.AccountName,
+		Reason:            req.Reason,
+	}, application); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReportApplicationResponse{}, nil
+}
+
+// saveReport stores report with a snapshot of the reported content, so
+// admins can still see what was reported after it has been changed.
+func (s *Server) saveReport(ctx context.Context, report *pb.Report, content proto.Message) error {
+	snapshot, err := protojson.Marshal(content)
+	if err != nil {
+		slog.ErrorContext(ctx, "protojson.Marshal", "err", err)
+		return status.Error(codes.Internal, "Failed to create report")
+	}
+	report.Id = uuid.New().String()
+	report.Snapshot = string(snapshot)
+	report.CreatedAtSec = time.Now().Unix()
+
+	saved, err := s.db.SaveReport(ctx, report)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveReport", "err", err)
+		return status.Error(codes.Internal, "Failed to create report")
+	}
+	if saved == nil {
+		return status.Error(codes.AlreadyExists, "Already reported")
+	}
+	return nil
+}
+
+// hideReportedGroup hides group once enough distinct accounts reported it.
+func (s *Server) hideReportedGroup(ctx context.Context, group *pb.Group) {
+	if s.config.ReportHideThreshold <= 0 || group.Hidden {
+		return
+	}
+
+	// Content policy flags are for admins, only players count towards hiding
+	reporters, err := s.db.CountOpenReporters(ctx, pb.ReportTargetType_REPORT_TARGET_GROUP, group.Id, contentPolicyReporter)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.CountOpenReporters", "err", err)
+		return
+	}
+	if reporters < s.config.ReportHideThreshold {
+		return
+	}
+
+	hidden, err := s.db.SetGroupHidden(ctx, group.Id, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SetGroupHidden", "err", err)
+		return
+	}
+	if hidden == nil {
+		return
+	}
+	slog.InfoContext(ctx, "group hidden after reports", "group_id", group.Id, "reporters", reporters)
+	s.broadcastGroupChange(group, hidden)
+}
+
+// contentPolicyReporter is the reporter of groups flagged by the content
+// policy. It is not a valid account name, so it can't collide with users.
+const contentPolicyReporter = "system:contentpolicy"
+
+// checkTitle applies the content policy to a group title, returning the
+// violations to flag once the group is saved.
+func (s *Server) checkTitle(title string) ([]contentpolicy.Violation, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Title is required")
+	}
+	verdict := s.contentPolicy.Check(title)
+	if verdict.Rejected != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Title %s", verdict.Rejected.Detail)
+	}
+	return verdict.Flagged, nil
+}
+
+// checkNote applies the content policy to an application note, returning
+// the violations to flag once the application is saved.
+func (s *Server) checkNote(note string) ([]contentpolicy.Violation, error) {
+	if note == "" {
+		return nil, nil
+	}
+	verdict := s.contentPolicy.Check(note)
+	if verdict.Rejected != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Note %s", verdict.Rejected.Detail)
+	}
+	return verdict.Flagged, nil
+}
+
+// flagGroup reports a group for the violations checkTitle flagged.
+func (s *Server) flagGroup(ctx context.Context, group *pb.Group, violations []contentpolicy.Violation) {
+	s.flag(ctx, &pb.Report{
+		TargetType:        pb.ReportTargetType_REPORT_TARGET_GROUP,
+		TargetId:          group.Id,
+		TargetAccountName: group.CreatorId,
+	}, group, violations)
+}
+
+// flagApplication reports an application for the violations checkNote
+// flagged.
+func (s *Server) flagApplication(ctx context.Context, application *pb.GroupApplication, violations []contentpolicy.Violation) {
+	s.flag(ctx, &pb.Report{
+		TargetType:        pb.ReportTargetType_REPORT_TARGET_APPLICATION,
+		TargetId:          application.Id,
+		TargetAccountName: application.AccountName,
+	}, application, violations)
+}
+
+func (s *Server) flag(ctx context.Context, report *pb.Report, content proto.Message, violations []contentpolicy.Violation) {
+	if len(violations) == 0 {
+		return
+	}
+	reasons := make([]string, len(violations))
+	for i, v := range violations {
+		reasons[i] = string(v.Rule) + ": " + v.Detail
+	}
+	report.Reporter = contentPolicyReporter
+	report.Reason = strings.Join(reasons, "; ")
+	err := s.saveReport(ctx, report, content)
+	// An open report from an earlier update is good enough
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		slog.ErrorContext(ctx, "s.saveReport", "err", err)
+	}
+}
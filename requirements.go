@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"gw2lfgserver/clientinfo"
+	"gw2lfgserver/keyresolver"
+	pb "gw2lfgserver/pb"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// knownAccess lists the game access values reported by the GW2 API.
+var knownAccess = []string{
+	"GuildWars2",
+	"HeartOfThorns",
+	"PathOfFire",
+	"EndOfDragons",
+	"SecretsOfTheObscure",
+	"JanthirWilds",
+	"PlayForFree",
+}
+
+// validateRequirements checks the account requirements a commander set on a group.
+func validateRequirements(group *pb.Group) error {
+	for _, access := range group.RequiredAccess {
+		if strings.TrimSpace(access) == "" {
+			return status.Error(codes.InvalidArgument, "Required access must not be empty")
+		}
+		if !slices.Contains(knownAccess, access) {
+			return status.Errorf(codes.InvalidArgument, "Unknown access %q", access)
+		}
+	}
+	if group.MinAccountAgeSec < 0 {
+		return status.Error(codes.InvalidArgument, "Minimum account age must not be negative")
+	}
+	return nil
+}
+
+// checkRequirements returns a FailedPrecondition error naming the first
+// account requirement of the group the client does not meet.
+func checkRequirements(group *pb.Group, client *clientinfo.ClientInfo) error {
+	if len(group.RequiredAccess) == 0 && !group.RequireCommander && group.MinFractalLevel == 0 && group.MinAccountAgeSec == 0 {
+		return nil
+	}
+
+	profile := client.Profile
+	if profile == nil {
+		return status.Error(codes.FailedPrecondition, "Group has account requirements, but your account profile is unavailable")
+	}
+	for _, access := range group.RequiredAccess {
+		if !slices.Contains(profile.Access, access) {
+			return status.Errorf(codes.FailedPrecondition, "Group requires %s", access)
+		}
+	}
+	if group.RequireCommander && !profile.Commander {
+		return status.Error(codes.FailedPrecondition, "Group requires a commander tag")
+	}
+	if group.MinFractalLevel > 0 {
+		if !client.HasPermission(keyresolver.PermissionProgression) {
+			return status.Errorf(codes.FailedPrecondition, "Group requires fractal level %d, which needs the %s permission on your API key", group.MinFractalLevel, keyresolver.PermissionProgression)
+		}
+		if uint32(profile.FractalLevel) < group.MinFractalLevel {
+			return status.Errorf(codes.FailedPrecondition, "Group requires fractal level %d", group.MinFractalLevel)
+		}
+	}
+	if minAge := time.Duration(group.MinAccountAgeSec) * time.Second; profile.Age < minAge {
+		return status.Errorf(codes.FailedPrecondition, "Group requires an account age of %s", formatHours(minAge))
+	}
+	return nil
+}
+
+func formatHours(d time.Duration) string {
+	return fmt.Sprintf("%d hours", int64(d/time.Hour))
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxScheduleAhead limits how far ahead groups can be posted.
+	maxScheduleAhead = 30 * 24 * time.Hour
+	// startSkew tolerates clients whose clocks are slightly behind.
+	startSkew = 5 * time.Minute
+)
+
+// validateStart checks the start time of a group created at now, or of an
+// update to existing. A start time that didn't change may lie in the past.
+func validateStart(group, existing *pb.Group, now time.Time) error {
+	if group.StartAtSec == 0 || (existing != nil && group.StartAtSec == existing.StartAtSec) {
+		return nil
+	}
+	start := time.Unix(group.StartAtSec, 0)
+	if start.Before(now.Add(-startSkew)) {
+		return status.Error(codes.InvalidArgument, "Start time must not be in the past")
+	}
+	if start.After(now.Add(maxScheduleAhead)) {
+		return status.Errorf(codes.InvalidArgument, "Start time must be within %d days", int(maxScheduleAhead.Hours()/24))
+	}
+	return nil
+}
+
+// groupState returns the lifecycle state of group at now. Groups without a
+// start time are forming for as long as they exist.
+func (s *Server) groupState(group *pb.Group, now time.Time) pb.GroupState {
+	if group.StartAtSec == 0 {
+		return pb.GroupState_GROUP_STATE_FORMING
+	}
+	start := time.Unix(group.StartAtSec, 0)
+	switch {
+	case now.Before(start.Add(-s.config.FormingLead)):
+		return pb.GroupState_GROUP_STATE_SCHEDULED
+	case now.Before(start):
+		return pb.GroupState_GROUP_STATE_FORMING
+	case s.config.CloseAfter > 0 && !now.Before(start.Add(s.config.CloseAfter)):
+		return pb.GroupState_GROUP_STATE_CLOSED
+	default:
+		return pb.GroupState_GROUP_STATE_IN_PROGRESS
+	}
+}
+
+// RunScheduler creates groups from recurring templates and moves groups
+// through their lifecycle states as their start time approaches and passes,
+// broadcasting each change.
+func (s *Server) RunScheduler(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			s.materialiseTemplates(ctx, now)
+			s.advanceGroupStates(ctx, now)
+		}
+	}
+}
+
+func (s *Server) advanceGroupStates(ctx context.Context, now time.Time) {
+	groups, err := s.db.ListGroups(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListGroups", "err", err)
+		return
+	}
+	for _, group := range groups {
+		state := s.groupState(group, now)
+		if state == group.State {
+			continue
+		}
+		updated, err := s.db.SetGroupState(ctx, group.Id, state, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.SetGroupState", "err", err)
+			continue
+		}
+		if updated != nil {
+			s.broadcastGroupUpdate(updated, updatedGroupUpdate)
+		}
+	}
+}
+
+// startOf returns when group starts, which is its creation for groups
+// without a start time.
+func startOf(group *pb.Group) int64 {
+	if group.StartAtSec != 0 {
+		return group.StartAtSec
+	}
+	return group.CreatedAtSec
+}
+
+// filterByStart drops groups starting outside the requested range and
+// applies the requested order.
+func filterByStart(groups []*pb.Group, req *pb.ListGroupsRequest) []*pb.Group {
+	groups = slices.DeleteFunc(groups, func(group *pb.Group) bool {
+		start := startOf(group)
+		return (req.StartAfterSec != 0 && start < req.StartAfterSec) ||
+			(req.StartBeforeSec != 0 && start > req.StartBeforeSec)
+	})
+	if req.Sort == pb.GroupSort_GROUP_SORT_START_TIME {
+		slices.SortStableFunc(groups, func(a, b *pb.Group) int {
+			return cmp.Compare(startOf(a), startOf(b))
+		})
+	}
+	return groups
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"gw2lfgserver/clientinfo"
+	"gw2lfgserver/contentpolicy"
 	"gw2lfgserver/database"
 	"gw2lfgserver/kpme"
 	pb "gw2lfgserver/pb"
 	"gw2lfgserver/syncmap"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,39 +18,65 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// ServerConfig holds configuration for the LFG service
+type ServerConfig struct {
+	// ReportHideThreshold is the number of distinct reporters after which a
+	// group is hidden until reviewed. Zero disables hiding.
+	ReportHideThreshold int
+	// FormingLead is how long before its start a scheduled group starts
+	// forming.
+	FormingLead time.Duration
+	// CloseAfter is how long after its start a scheduled group stops
+	// accepting applications. Zero keeps it open until it expires.
+	CloseAfter time.Duration
+}
+
 type Server struct {
 	pb.UnimplementedLfgServiceServer
+	config                    ServerConfig
 	db                        *database.DB
+	contentPolicy             *contentpolicy.Policy
 	kpClient                  *kpme.Client
-	groupsSubscribers         *syncmap.Map[string, chan *pb.GroupsUpdate]
+	groupsSubscribers         *syncmap.Map[string, *groupsSubscriber]
 	applicationsSubscribers   *syncmap.Map[string, *syncmap.Map[string, chan *pb.GroupApplicationUpdate]]
 	myApplicationsSubscribers *syncmap.Map[string, chan *pb.GroupApplicationUpdate]
+	// subscriptions lists all open streams for admins
+	subscriptions *syncmap.Map[string, *pb.Subscription]
+	slotLocks     slotLocks
+}
+
+type groupsSubscriber struct {
+	client  *clientinfo.ClientInfo
+	updates chan *pb.GroupsUpdate
 }
 
-func NewServer(db *database.DB, kpClient *kpme.Client) *Server {
+func NewServer(config ServerConfig, db *database.DB, contentPolicy *contentpolicy.Policy, kpClient *kpme.Client) *Server {
 	return &Server{
+		config:                    config,
 		db:                        db,
+		contentPolicy:             contentPolicy,
 		kpClient:                  kpClient,
-		groupsSubscribers:         syncmap.New[string, chan *pb.GroupsUpdate](),
+		groupsSubscribers:         syncmap.New[string, *groupsSubscriber](),
 		applicationsSubscribers:   syncmap.New[string, *syncmap.Map[string, chan *pb.GroupApplicationUpdate]](),
 		myApplicationsSubscribers: syncmap.New[string, chan *pb.GroupApplicationUpdate](),
+		subscriptions:             syncmap.New[string, *pb.Subscription](),
 	}
 }
 
 func (s *Server) SubscribeGroups(req *pb.SubscribeGroupsRequest, stream pb.LfgService_SubscribeGroupsServer) error {
+	// Nil for anonymous subscribers, who only get to see public groups
 	clientInfo := clientinfo.FromContext(stream.Context())
-	if clientInfo == nil {
-		return status.Error(codes.PermissionDenied, "Not authenticated")
-	}
 
 	updates := make(chan *pb.GroupsUpdate, 100)
 
-	// Register subscriber
-	// TODO: What if the same user subscribes twice?
-	s.groupsSubscribers.Set(clientInfo.Token, updates)
+	// Register subscriber, keyed per stream so the same user can subscribe twice
+	subscriptionID := uuid.New().String()
+	s.groupsSubscribers.Set(subscriptionID, &groupsSubscriber{client: clientInfo, updates: updates})
+	s.trackSubscription(subscriptionID, "SubscribeGroups", "", clientInfo)
 
 	defer func() {
-		s.groupsSubscribers.Delete(clientInfo.Token)
+		s.subscriptions.Delete(subscriptionID)
+		s.groupsSubscribers.Delete(subscriptionID)
 		close(updates)
 	}()
 
@@ -72,49 +101,134 @@ func (s *Server) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*
 		return nil, err
 	}
 
-	now := time.Now()
+	group, flagged, err := s.newGroup(client.AccountName, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVisibility(group, client); err != nil {
+		return nil, err
+	}
+
+	savedGroup, err := s.saveNewGroup(ctx, group, flagged)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateGroupResponse{Group: savedGroup}, nil
+}
+
+// newGroup builds and validates a group from req. Checks that depend on the
+// creator's account are left to the caller.
+func (s *Server) newGroup(creator string, req *pb.CreateGroupRequest, now time.Time) (*pb.Group, []contentpolicy.Violation, error) {
 	group := &pb.Group{
 		Id:               uuid.New().String(),
-		CreatorId:        client.AccountName,
+		CreatorId:        creator,
 		Title:            req.Title,
 		KillProofId:      req.KillProofId,
 		KillProofMinimum: req.KillProofMinimum,
 		CreatedAtSec:     now.Unix(),
 		UpdatedAtSec:     now.Unix(),
+		ModifiedAtSec:    now.Unix(),
+		RequiredAccess:   req.RequiredAccess,
+		RequireCommander: req.RequireCommander,
+		MinFractalLevel:  req.MinFractalLevel,
+		MinAccountAgeSec: req.MinAccountAgeSec,
+		Visibility:       req.Visibility,
+		GuildIds:         req.GuildIds,
+		StartAtSec:       req.StartAtSec,
+		Slots:            req.Slots,
+		AutoAccept:       req.AutoAccept,
+	}
+	if err := validateStart(group, nil, now); err != nil {
+		return nil, nil, err
+	}
+	group.State = s.groupState(group, now)
+	flagged, err := s.checkTitle(group.Title)
+	if err != nil {
+		return nil, nil, err
 	}
+	if err := validateRequirements(group); err != nil {
+		return nil, nil, err
+	}
+	if err := validateSlots(group, nil); err != nil {
+		return nil, nil, err
+	}
+	if err := validateAutoAccept(group); err != nil {
+		return nil, nil, err
+	}
+	if group.Visibility == pb.GroupVisibility_VISIBILITY_UNLISTED {
+		group.InviteCode = newInviteCode()
+	}
+	return group, flagged, nil
+}
 
+// saveNewGroup stores a group built by newGroup and announces it.
+func (s *Server) saveNewGroup(ctx context.Context, group *pb.Group, flagged []contentpolicy.Violation) (*pb.Group, error) {
 	savedGroup, err := s.db.SaveGroup(ctx, group)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.SaveGroup", "err", err)
 		return nil, status.Error(codes.Internal, "Failed to create group")
 	}
+	s.flagGroup(ctx, savedGroup, flagged)
 
-	s.broadcastGroupUpdate(&pb.GroupsUpdate{
-		Update: &pb.GroupsUpdate_NewGroup{NewGroup: savedGroup},
-	})
+	s.broadcastGroupUpdate(savedGroup, newGroupUpdate)
 
-	return &pb.CreateGroupResponse{Group: savedGroup}, nil
+	return savedGroup, nil
 }
 
 func (s *Server) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
 	client := mustGetClient(ctx)
 	group := req.GetGroup()
+	if group == nil {
+		return nil, status.Error(codes.InvalidArgument, "Group is required")
+	}
 
-	if err := s.validateGroupOwnership(ctx, group.Id, client.AccountName); err != nil {
+	// Fill counts are carried over from existing
+	unlock := s.slotLocks.lock(group.Id)
+	defer unlock()
+	existing, err := s.validateGroupOwnership(ctx, group.Id, client.AccountName)
+	if err != nil {
 		return nil, err
 	}
-
 	now := time.Now()
+	if err := validateStart(group, existing, now); err != nil {
+		return nil, err
+	}
+	group.State = s.groupState(group, now)
+	flagged, err := s.checkTitle(group.Title)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRequirements(group); err != nil {
+		return nil, err
+	}
+	if err := validateSlots(group, existing); err != nil {
+		return nil, err
+	}
+	if err := validateAutoAccept(group); err != nil {
+		return nil, err
+	}
+	if err := validateVisibility(group, client); err != nil {
+		return nil, err
+	}
+	// The invite code is generated by the server and survives updates
+	group.InviteCode = ""
+	if group.Visibility == pb.GroupVisibility_VISIBILITY_UNLISTED {
+		group.InviteCode = existing.InviteCode
+		if group.InviteCode == "" {
+			group.InviteCode = newInviteCode()
+		}
+	}
+
 	group.UpdatedAtSec = now.Unix()
+	group.ModifiedAtSec = now.Unix()
 	savedGroup, err := s.db.SaveGroup(ctx, group)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.SaveGroup", "err", err)
 		return nil, status.Error(codes.Internal, "Failed to update group")
 	}
+	s.flagGroup(ctx, savedGroup, flagged)
 
-	s.broadcastGroupUpdate(&pb.GroupsUpdate{
-		Update: &pb.GroupsUpdate_UpdatedGroup{UpdatedGroup: savedGroup},
-	})
+	s.broadcastGroupChange(existing, savedGroup)
 
 	return &pb.UpdateGroupResponse{Group: savedGroup}, nil
 }
@@ -134,19 +248,37 @@ func (s *Server) validateNewGroup(ctx context.Context, accountID string) error {
 	return nil
 }
 
-func (s *Server) validateGroupOwnership(ctx context.Context, groupID, accountID string) error {
+func (s *Server) validateGroupOwnership(ctx context.Context, groupID, accountID string) (*pb.Group, error) {
 	group, err := s.db.GetGroup(ctx, groupID)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
-		return status.Error(codes.Internal, "Failed to validate group ownership")
+		return nil, status.Error(codes.Internal, "Failed to validate group ownership")
 	}
 	if group == nil {
-		return status.Error(codes.NotFound, "Group not found")
+		return nil, status.Error(codes.NotFound, "Group not found")
 	}
 	if group.CreatorId != accountID {
-		return status.Error(codes.PermissionDenied, "Not group owner")
+		return nil, status.Error(codes.PermissionDenied, "Not group owner")
 	}
-	return nil
+	return group, nil
+}
+
+// accountProfileToProto returns the part of a profile that commanders get
+// to see when judging applicants.
+func accountProfileToProto(p *clientinfo.Profile) *pb.AccountProfile {
+	if p == nil {
+		return nil
+	}
+	profile := &pb.AccountProfile{
+		AgeSec:       int64(p.Age / time.Second),
+		Commander:    p.Commander,
+		FractalLevel: uint32(p.FractalLevel),
+		Access:       p.Access,
+	}
+	if !p.Created.IsZero() {
+		profile.AccountCreatedAtSec = p.Created.Unix()
+	}
+	return profile
 }
 
 func mustGetClient(ctx context.Context) *clientinfo.ClientInfo {
@@ -175,62 +307,121 @@ func (s *Server) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*
 		return nil, status.Error(codes.PermissionDenied, "Not group creator")
 	}
 
-	// Delete from database
-	if err := s.db.DeleteGroup(ctx, group.Id); err != nil {
-		slog.ErrorContext(ctx, "s.db.DeleteGroup", "err", err)
-		return nil, status.Error(codes.Internal, "Failed to delete group")
+	if err := s.deleteGroup(ctx, group); err != nil {
+		return nil, err
 	}
 
-	// Broadcast to all subscribers
-	s.broadcastGroupUpdate(&pb.GroupsUpdate{
-		Update: &pb.GroupsUpdate_RemovedGroupId{
-			RemovedGroupId: group.Id,
-		},
-	})
-
 	return &pb.DeleteGroupResponse{}, nil
 }
 
+// deleteGroup deletes a group with its applications and invitations and
+// announces the removal of all of them.
+func (s *Server) deleteGroup(ctx context.Context, group *pb.Group) error {
+	s.recordCancellation(ctx, group)
+
+	deleted, err := s.db.DeleteGroup(ctx, group.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.DeleteGroup", "err", err)
+		return status.Error(codes.Internal, "Failed to delete group")
+	}
+
+	for _, invitation := range deleted.Invitations {
+		s.broadcastApplicationUpdate(invitation.GroupId, invitation.AccountName, removedInvitationUpdate(invitation))
+	}
+	for _, app := range deleted.Applications {
+		s.broadcastApplicationUpdate(app.GroupId, app.AccountName, &pb.GroupApplicationUpdate{
+			Update: &pb.GroupApplicationUpdate_RemovedApplicationId{
+				RemovedApplicationId: app.Id,
+			},
+		})
+	}
+	s.broadcastGroupUpdate(group, removedGroupUpdate)
+	return nil
+}
+
 func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
 	groups, err := s.db.ListGroups(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.ListGroups", "err", err)
 		return nil, status.Error(codes.Internal, "Failed to list groups")
 	}
+	client := clientinfo.FromContext(ctx)
+	groups = slices.DeleteFunc(groups, func(group *pb.Group) bool {
+		if !canView(group, client, "") {
+			return true
+		}
+		return req.EligibleOnly && client != nil && group.CreatorId != client.AccountName && checkRequirements(group, client) != nil
+	})
+	groups = filterByStart(groups, req)
+	groups = filterBySlots(groups, req)
+	for i, group := range groups {
+		groups[i] = groupForClient(group, client)
+	}
 	return &pb.ListGroupsResponse{
 		Groups: groups,
 	}, nil
 }
 
+func (s *Server) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupResponse, error) {
+	client := clientinfo.FromContext(ctx)
+
+	var group *pb.Group
+	var err error
+	switch {
+	case req.GroupId != "":
+		group, err = s.db.GetGroup(ctx, req.GroupId)
+	case req.InviteCode != "":
+		group, err = s.db.GetGroupByInviteCode(ctx, req.InviteCode)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Either group ID or invite code must be provided")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get group")
+	}
+	// Hidden groups are indistinguishable from missing ones
+	if group == nil || !canView(group, client, req.InviteCode) {
+		return nil, status.Error(codes.NotFound, "Group not found")
+	}
+
+	return &pb.GetGroupResponse{Group: groupForClient(group, client)}, nil
+}
+
 // Application Management
 func (s *Server) CreateGroupApplication(ctx context.Context, req *pb.CreateGroupApplicationRequest) (*pb.CreateGroupApplicationResponse, error) {
 	client := mustGetClient(ctx)
 
-	if err := s.validateApplication(ctx, req.GroupId, client.AccountName); err != nil {
-		return nil, err
-	}
-
 	now := time.Now()
 	application := &pb.GroupApplication{
-		Id:           uuid.New().String(),
-		AccountName:  client.AccountName,
-		GroupId:      req.GroupId,
-		CreatedAtSec: now.Unix(),
-		UpdatedAtSec: now.Unix(),
+		Id:               uuid.New().String(),
+		AccountName:      client.AccountName,
+		GroupId:          req.GroupId,
+		CreatedAtSec:     now.Unix(),
+		UpdatedAtSec:     now.Unix(),
+		ApplicantProfile: accountProfileToProto(client.Profile),
+		Role:             req.Role,
+		Boon:             req.Boon,
+		Profession:       req.Profession,
+		EliteSpec:        req.EliteSpec,
+		Note:             strings.TrimSpace(req.Note),
+	}
+	if err := validateLoadout(application); err != nil {
+		return nil, err
 	}
-
-	savedApp, err := s.db.SaveApplication(ctx, application, req.GroupId)
+	if _, err := s.validateApplication(ctx, application, req.InviteCode, client); err != nil {
+		return nil, err
+	}
+	flagged, err := s.checkNote(application.Note)
 	if err != nil {
-		slog.ErrorContext(ctx, "s.db.SaveApplication", "err", err)
-		return nil, status.Error(codes.Internal, "Failed to create application")
+		return nil, err
 	}
+	s.attachKillProof(ctx, application)
 
-	kp, err := s.kpClient.GetKillProof(savedApp.AccountName)
+	savedApp, err := s.saveNewApplication(ctx, application)
 	if err != nil {
-		slog.ErrorContext(ctx, "s.kpClient.GetKillProof", "err", err)
-	} else if kp != nil {
-		savedApp.KillProof = kpme.KillProofReponseToProto(kp)
+		return nil, err
 	}
+	s.flagApplication(ctx, savedApp, flagged)
 
 	s.broadcastApplicationUpdate(req.GroupId, savedApp.AccountName, &pb.GroupApplicationUpdate{
 		Update: &pb.GroupApplicationUpdate_NewApplication{NewApplication: savedApp},
@@ -239,31 +430,159 @@ func (s *Server) CreateGroupApplication(ctx context.Context, req *pb.CreateGroup
 	return &pb.CreateGroupApplicationResponse{Application: savedApp}, nil
 }
 
-func (s *Server) validateApplication(ctx context.Context, groupID, accountID string) error {
-	group, err := s.db.GetGroup(ctx, groupID)
+// UpdateGroupApplication changes what the applicant brings. The creation
+// time stays, so the application keeps its place in the queue.
+func (s *Server) UpdateGroupApplication(ctx context.Context, req *pb.UpdateGroupApplicationRequest) (*pb.UpdateGroupApplicationResponse, error) {
+	client := mustGetClient(ctx)
+
+	// Read again under the lock of its group, so review can't change the
+	// application's status in between
+	application, err := s.getOwnApplication(ctx, req.ApplicationId, client)
+	if err != nil {
+		return nil, err
+	}
+	// Fetched before locking, kill proof comes from an external API
+	s.attachKillProof(ctx, application)
+	killProof := application.KillProof
+
+	unlock := s.slotLocks.lock(application.GroupId)
+	defer unlock()
+	application, err = s.getOwnApplication(ctx, req.ApplicationId, client)
+	if err != nil {
+		return nil, err
+	}
+
+	roleChanged := application.Role != req.Role || application.Boon != req.Boon
+	wasAccepted := application.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED
+	application.Role = req.Role
+	application.Boon = req.Boon
+	application.Profession = req.Profession
+	application.EliteSpec = req.EliteSpec
+	application.Note = strings.TrimSpace(req.Note)
+	application.UpdatedAtSec = time.Now().Unix()
+	if err := validateLoadout(application); err != nil {
+		return nil, err
+	}
+	if roleChanged {
+		group, err := s.db.GetGroup(ctx, application.GroupId)
+		if err != nil {
+			slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+			return nil, status.Error(codes.Internal, "Failed to get group")
+		}
+		if group == nil {
+			return nil, status.Error(codes.NotFound, "Group not found")
+		}
+		// The applicant's own place is about to be freed
+		if wasAccepted {
+			for _, slot := range group.Slots {
+				if slot.Id == application.SlotId && slot.Filled > 0 {
+					slot.Filled--
+				}
+			}
+		}
+		// The commander has to review the new role
+		application.Status = pb.ApplicationStatus_APPLICATION_STATUS_PENDING
+		application.SlotId = ""
+		if err := checkSlot(group, application); err != nil {
+			return nil, err
+		}
+	}
+	flagged, err := s.checkNote(application.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	savedApp, err := s.db.SaveApplication(ctx, application, application.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to update application")
+	}
+	s.flagApplication(ctx, savedApp, flagged)
+	if roleChanged && wasAccepted {
+		s.refreshSlots(ctx, savedApp.GroupId)
+	}
+	savedApp.KillProof = killProof
+
+	s.broadcastApplicationUpdate(savedApp.GroupId, savedApp.AccountName, &pb.GroupApplicationUpdate{
+		Update: &pb.GroupApplicationUpdate_UpdatedApplication{UpdatedApplication: savedApp},
+	})
+
+	return &pb.UpdateGroupApplicationResponse{Application: savedApp}, nil
+}
+
+// getOwnApplication returns the application with id if client made it.
+func (s *Server) getOwnApplication(ctx context.Context, id string, client *clientinfo.ClientInfo) (*pb.GroupApplication, error) {
+	application, err := s.db.GetApplication(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get application")
+	}
+	if application == nil {
+		return nil, status.Error(codes.NotFound, "Application not found")
+	}
+	if application.AccountName != client.AccountName {
+		return nil, status.Error(codes.PermissionDenied, "Not application owner")
+	}
+	return application, nil
+}
+
+// attachKillProof adds the applicant's kill proof to application.
+func (s *Server) attachKillProof(ctx context.Context, application *pb.GroupApplication) {
+	kp, err := s.kpClient.GetKillProof(application.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.kpClient.GetKillProof", "err", err)
+	} else if kp != nil {
+		application.KillProof = kpme.KillProofReponseToProto(kp)
+	}
+}
+
+// validateApplication returns the group client applies to if application
+// may be made.
+func (s *Server) validateApplication(ctx context.Context, application *pb.GroupApplication, inviteCode string, client *clientinfo.ClientInfo) (*pb.Group, error) {
+	group, err := s.db.GetGroup(ctx, application.GroupId)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
-		return status.Error(codes.Internal, "Failed to validate application")
+		return nil, status.Error(codes.Internal, "Failed to validate application")
 	}
-	if group == nil {
-		return status.Error(codes.NotFound, "Group not found")
+	if group == nil || !canView(group, client, inviteCode) {
+		return nil, status.Error(codes.NotFound, "Group not found")
 	}
-	if group.CreatorId == accountID {
+	return group, s.checkApplication(ctx, group, application, client)
+}
+
+// checkApplication checks an application of client to a group it can see.
+func (s *Server) checkApplication(ctx context.Context, group *pb.Group, application *pb.GroupApplication, client *clientinfo.ClientInfo) error {
+	if group.CreatorId == client.AccountName {
 		return status.Error(codes.PermissionDenied, "Cannot apply to own group")
 	}
+	if group.State == pb.GroupState_GROUP_STATE_CLOSED {
+		return status.Error(codes.FailedPrecondition, "Group is closed")
+	}
+
+	blocked, err := s.db.IsBlocked(ctx, group.CreatorId, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.IsBlocked", "err", err)
+		return status.Error(codes.Internal, "Failed to validate application")
+	}
+	if blocked {
+		return status.Error(codes.PermissionDenied, "Cannot apply to this group")
+	}
 
-	applications, err := s.db.ListApplicationsForGroup(ctx, groupID)
+	applications, err := s.db.ListApplicationsForGroup(ctx, group.Id)
 	if err != nil {
 		slog.ErrorContext(ctx, "s.db.ListApplications", "err", err)
 		return status.Error(codes.Internal, "Failed to check existing applications")
 	}
 
 	for _, app := range applications {
-		if app.AccountName == accountID {
+		if app.AccountName == client.AccountName {
 			return status.Error(codes.AlreadyExists, "Already applied to this group")
 		}
 	}
-	return nil
+	if err := checkSlot(group, application); err != nil {
+		return err
+	}
+	return checkRequirements(group, client)
 }
 
 func (s *Server) DeleteGroupApplication(ctx context.Context, req *pb.DeleteGroupApplicationRequest) (*pb.DeleteGroupApplicationResponse, error) {
@@ -289,6 +608,7 @@ func (s *Server) DeleteGroupApplication(ctx context.Context, req *pb.DeleteGroup
 		slog.ErrorContext(ctx, "s.db.DeleteApplication", "err", err)
 		return nil, status.Error(codes.Internal, "Failed to delete application")
 	}
+	s.releaseSlots(ctx, application)
 
 	s.broadcastApplicationUpdate(application.GroupId, application.AccountName, &pb.GroupApplicationUpdate{ // Update this line
 		Update: &pb.GroupApplicationUpdate_RemovedApplicationId{
@@ -383,18 +703,22 @@ func (s *Server) SubscribeGroupApplications(req *pb.SubscribeGroupApplicationsRe
 				if subscribers == nil {
 					subscribers = syncmap.New[string, chan *pb.GroupApplicationUpdate]()
 				}
-				subscribers.Set(clientInfo.Token, applications)
+				subscribers.Set(clientInfo.TokenHash, applications)
 				return subscribers, true
 			})
 	}
 
+	subscriptionID := uuid.New().String()
+	s.trackSubscription(subscriptionID, "SubscribeGroupApplications", req.GroupId, clientInfo)
+
 	// TODO: What if the group gets deleted?
 	defer func() {
+		s.subscriptions.Delete(subscriptionID)
 		subs, ok := s.applicationsSubscribers.Get(req.GroupId)
 		if ok {
-			subs.Delete(clientInfo.Token)
+			subs.Delete(clientInfo.TokenHash)
 		}
-		s.myApplicationsSubscribers.Delete(clientInfo.Token)
+		s.myApplicationsSubscribers.Delete(clientInfo.AccountName)
 		close(applications)
 	}()
 
@@ -424,11 +748,7 @@ func (s *Server) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.H
 
 	// Broadcast updates for touched groups
 	for _, group := range result.Groups {
-		s.broadcastGroupUpdate(&pb.GroupsUpdate{
-			Update: &pb.GroupsUpdate_UpdatedGroup{
-				UpdatedGroup: group,
-			},
-		})
+		s.broadcastGroupUpdate(group, updatedGroupUpdate)
 	}
 
 	// Broadcast updates for touched applications
@@ -443,6 +763,48 @@ func (s *Server) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.H
 	return &pb.HeartbeatResponse{}, nil
 }
 
+// Block lists
+func (s *Server) BlockAccount(ctx context.Context, req *pb.BlockAccountRequest) (*pb.BlockAccountResponse, error) {
+	client := mustGetClient(ctx)
+	if req.AccountName == "" {
+		return nil, status.Error(codes.InvalidArgument, "Account name is required")
+	}
+	if req.AccountName == client.AccountName {
+		return nil, status.Error(codes.InvalidArgument, "Cannot block yourself")
+	}
+
+	if err := s.db.BlockAccount(ctx, client.AccountName, req.AccountName, time.Now()); err != nil {
+		slog.ErrorContext(ctx, "s.db.BlockAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to block account")
+	}
+	return &pb.BlockAccountResponse{}, nil
+}
+
+func (s *Server) UnblockAccount(ctx context.Context, req *pb.UnblockAccountRequest) (*pb.UnblockAccountResponse, error) {
+	client := mustGetClient(ctx)
+
+	found, err := s.db.UnblockAccount(ctx, client.AccountName, req.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.UnblockAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to unblock account")
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "Account is not blocked")
+	}
+	return &pb.UnblockAccountResponse{}, nil
+}
+
+func (s *Server) ListBlocked(ctx context.Context, req *pb.ListBlockedRequest) (*pb.ListBlockedResponse, error) {
+	client := mustGetClient(ctx)
+
+	blocked, err := s.db.ListBlocked(ctx, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListBlocked", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list blocked accounts")
+	}
+	return &pb.ListBlockedResponse{Blocked: blocked}, nil
+}
+
 func (s *Server) CleanUpExpiredDatabaseEntries(ctx context.Context, dbEntryTTL time.Duration, cleanupInterval time.Duration) {
 	t := time.NewTicker(cleanupInterval)
 	defer t.Stop()
@@ -452,9 +814,18 @@ func (s *Server) CleanUpExpiredDatabaseEntries(ctx context.Context, dbEntryTTL t
 			return
 		case <-t.C:
 			// Delete applications before groups to avoid the foreign key constraint
-			applications, err := s.db.DeleteApplicationsUpdatedBefore(ctx, time.Now().Add(-dbEntryTTL))
+			applications, err := s.db.DeleteApplicationsExpiredBefore(ctx, time.Now().Add(-dbEntryTTL))
 			if err != nil {
-				slog.ErrorContext(ctx, "s.db.DeleteApplicationsUpdatedBefore", "err", err)
+				slog.ErrorContext(ctx, "s.db.DeleteApplicationsExpiredBefore", "err", err)
+			}
+			s.releaseSlots(ctx, applications...)
+
+			invitations, err := s.db.DeleteInvitationsExpiredBefore(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "s.db.DeleteInvitationsExpiredBefore", "err", err)
+			}
+			for _, invitation := range invitations {
+				s.broadcastApplicationUpdate(invitation.GroupId, invitation.AccountName, removedInvitationUpdate(invitation))
 			}
 			for _, app := range applications {
 				s.broadcastApplicationUpdate(app.GroupId, app.AccountName, &pb.GroupApplicationUpdate{
@@ -464,31 +835,81 @@ func (s *Server) CleanUpExpiredDatabaseEntries(ctx context.Context, dbEntryTTL t
 				})
 			}
 
-			groups, err := s.db.DeleteGroupsUpdatedBefore(ctx, time.Now().Add(-dbEntryTTL))
+			groups, err := s.db.DeleteGroupsExpiredBefore(ctx, time.Now().Add(-dbEntryTTL))
 			if err != nil {
-				slog.ErrorContext(ctx, "s.db.DeleteGroupsUpdatedBefore", "err", err)
+				slog.ErrorContext(ctx, "s.db.DeleteGroupsExpiredBefore", "err", err)
 			}
 			for _, group := range groups {
-				s.broadcastGroupUpdate(&pb.GroupsUpdate{
-					Update: &pb.GroupsUpdate_RemovedGroupId{
-						RemovedGroupId: group.Id,
-					},
-				})
+				s.broadcastGroupUpdate(group, removedGroupUpdate)
+			}
+
+			if err := s.db.DeleteTombstonesBefore(ctx, time.Now()); err != nil {
+				slog.ErrorContext(ctx, "s.db.DeleteTombstonesBefore", "err", err)
 			}
 		}
 	}
 }
 
-func (s *Server) broadcastGroupUpdate(update *pb.GroupsUpdate) {
-	for _, ch := range s.groupsSubscribers.Snapshot() {
-		select {
-		case ch <- update:
-		default:
-			// Channel full, skip
+func newGroupUpdate(group *pb.Group) *pb.GroupsUpdate {
+	return &pb.GroupsUpdate{Update: &pb.GroupsUpdate_NewGroup{NewGroup: group}}
+}
+
+func updatedGroupUpdate(group *pb.Group) *pb.GroupsUpdate {
+	return &pb.GroupsUpdate{Update: &pb.GroupsUpdate_UpdatedGroup{UpdatedGroup: group}}
+}
+
+func removedGroupUpdate(group *pb.Group) *pb.GroupsUpdate {
+	return &pb.GroupsUpdate{Update: &pb.GroupsUpdate_RemovedGroupId{RemovedGroupId: group.Id}}
+}
+
+// broadcastGroupUpdate sends the update built by newUpdate to every
+// subscriber that can see the group.
+func (s *Server) broadcastGroupUpdate(group *pb.Group, newUpdate func(*pb.Group) *pb.GroupsUpdate) {
+	for _, sub := range s.groupsSubscribers.Snapshot() {
+		if canView(group, sub.client, "") {
+			sub.send(newUpdate(groupForClient(group, sub.client)))
 		}
 	}
 }
 
+// broadcastGroupChange announces an updated group. Subscribers that could
+// only see one version of the group get an addition or removal instead.
+func (s *Server) broadcastGroupChange(before, after *pb.Group) {
+	for _, sub := range s.groupsSubscribers.Snapshot() {
+		sawBefore, seesAfter := canView(before, sub.client, ""), canView(after, sub.client, "")
+		switch {
+		case sawBefore && seesAfter:
+			sub.send(updatedGroupUpdate(groupForClient(after, sub.client)))
+		case seesAfter:
+			sub.send(newGroupUpdate(groupForClient(after, sub.client)))
+		case sawBefore:
+			sub.send(removedGroupUpdate(before))
+		}
+	}
+}
+
+// trackSubscription records an open stream so admins can list it.
+func (s *Server) trackSubscription(id, method, groupID string, client *clientinfo.ClientInfo) {
+	subscription := &pb.Subscription{
+		Id:           id,
+		Method:       method,
+		GroupId:      groupID,
+		StartedAtSec: time.Now().Unix(),
+	}
+	if client != nil {
+		subscription.AccountName = client.AccountName
+	}
+	s.subscriptions.Set(id, subscription)
+}
+
+func (sub *groupsSubscriber) send(update *pb.GroupsUpdate) {
+	select {
+	case sub.updates <- update:
+	default:
+		// Channel full, skip
+	}
+}
+
 func (s *Server) broadcastApplicationUpdate(groupId, applicantAccountName string, update *pb.GroupApplicationUpdate) {
 	if subscribers, ok := s.applicationsSubscribers.Get(groupId); ok {
 		for _, ch := range subscribers.Snapshot() {
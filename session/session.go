@@ -0,0 +1,152 @@
+// Package session issues and verifies HMAC signed session tokens, so that
+// clients only have to send their GW2 API key once at login.
+package session
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gw2lfgserver/clientinfo"
+	"strings"
+	"time"
+)
+
+// Prefix marks session tokens. GW2 API keys and subtokens never start with
+// it, so both can be sent as bearer token.
+const Prefix = "lfg1."
+
+const (
+	TypeSession = "session"
+	TypeRefresh = "refresh"
+)
+
+var (
+	ErrMalformed = errors.New("malformed session token")
+	ErrSignature = errors.New("invalid session token signature")
+	ErrExpired   = errors.New("session token expired")
+	ErrType      = errors.New("wrong session token type")
+)
+
+// Claims are the signed contents of a token.
+type Claims struct {
+	Type        string              `json:"typ"`
+	KeyID       string              `json:"kid"`
+	AccountName string              `json:"sub"`
+	Permissions []string            `json:"perms,omitempty"`
+	Profile     *clientinfo.Profile `json:"profile,omitempty"`
+	IssuedAt    int64               `json:"iat"`
+	ExpiresAt   int64               `json:"exp"`
+}
+
+// Key is a signing secret. Keys are identified by ID so they can be rotated
+// without invalidating tokens signed with the previous key.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// ParseKeys parses a comma separated list of "id:secret" pairs.
+func ParseKeys(s string) ([]Key, error) {
+	var keys []Key
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(pair, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, fmt.Errorf("invalid signing key %q, expected id:secret", id)
+		}
+		keys = append(keys, Key{ID: id, Secret: []byte(secret)})
+	}
+	return keys, nil
+}
+
+// RandomKey returns an ephemeral key. Tokens signed with it do not survive
+// a restart.
+func RandomKey() Key {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return Key{ID: "ephemeral", Secret: secret}
+}
+
+type Signer struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewSigner creates a signer that signs with the first key and accepts
+// tokens signed by any of the keys.
+func NewSigner(keys ...Key) (*Signer, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one signing key is required")
+	}
+	s := &Signer{current: keys[0].ID, keys: make(map[string][]byte, len(keys))}
+	for _, k := range keys {
+		if _, ok := s.keys[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate signing key id %q", k.ID)
+		}
+		s.keys[k.ID] = k.Secret
+	}
+	return s, nil
+}
+
+// Sign returns a token for the claims, signed with the current key.
+func (s *Signer) Sign(claims Claims) (string, error) {
+	claims.KeyID = s.current
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := Prefix + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sign(s.keys[s.current], unsigned)), nil
+}
+
+// Verify checks the token signature, type and expiry and returns its claims.
+func (s *Signer) Verify(token, tokenType string) (*Claims, error) {
+	if !strings.HasPrefix(token, Prefix) {
+		return nil, ErrMalformed
+	}
+	i := strings.LastIndexByte(token, '.')
+	if i < len(Prefix) {
+		return nil, ErrMalformed
+	}
+	unsigned, encodedSig := token[:i], token[i+1:]
+
+	payload, err := base64.RawURLEncoding.DecodeString(unsigned[len(Prefix):])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformed
+	}
+
+	secret, ok := s.keys[claims.KeyID]
+	if !ok || !hmac.Equal(sig, sign(secret, unsigned)) {
+		return nil, ErrSignature
+	}
+	if claims.Type != tokenType {
+		return nil, ErrType
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrExpired
+	}
+	return &claims, nil
+}
+
+func sign(secret []byte, unsigned string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxSlots = 10
+	// maxSlotPlaces is the size of a full squad.
+	maxSlotPlaces = 50
+)
+
+// slotLocks serializes changes to the accepted applications of each group,
+// so checks against its slots and auto-accept capacity see every earlier
+// accept.
+type slotLocks struct {
+	mu    sync.Mutex
+	locks map[string]*slotLock
+}
+
+type slotLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks the group and returns the function unlocking it.
+func (l *slotLocks) lock(groupID string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*slotLock)
+	}
+	lock, ok := l.locks[groupID]
+	if !ok {
+		lock = &slotLock{}
+		l.locks[groupID] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if lock.refs--; lock.refs == 0 {
+			delete(l.locks, groupID)
+		}
+	}
+}
+
+// validateSlots checks the role slots of a group created, or updated from
+// existing, by its creator and assigns ids to new slots. Fill counts are
+// maintained by the server, so they are carried over from existing.
+func validateSlots(group, existing *pb.Group) error {
+	if len(group.Slots) > maxSlots {
+		return status.Errorf(codes.InvalidArgument, "At most %d slots", maxSlots)
+	}
+	filled := make(map[string]uint32)
+	if existing != nil {
+		for _, slot := range existing.Slots {
+			filled[slot.Id] = slot.Filled
+		}
+	}
+
+	seen := make(map[string]bool, len(group.Slots))
+	places := 0
+	for _, slot := range group.Slots {
+		if slot.Id == "" {
+			slot.Id = uuid.New().String()
+		}
+		if seen[slot.Id] {
+			return status.Error(codes.InvalidArgument, "Duplicate slot id")
+		}
+		seen[slot.Id] = true
+
+		if _, ok := pb.Role_name[int32(slot.Role)]; !ok || slot.Role == pb.Role_ROLE_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "Slot requires a role")
+		}
+		if _, ok := pb.Boon_name[int32(slot.Boon)]; !ok {
+			return status.Error(codes.InvalidArgument, "Unknown boon")
+		}
+		if slot.Role == pb.Role_ROLE_DPS && slot.Boon != pb.Boon_BOON_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "Only healers and boon DPS provide a boon")
+		}
+		if slot.Count == 0 {
+			return status.Error(codes.InvalidArgument, "Slot requires at least one place")
+		}
+		places += int(slot.Count)
+
+		slot.Filled = filled[slot.Id]
+		delete(filled, slot.Id)
+		if slot.Filled > slot.Count {
+			return status.Error(codes.FailedPrecondition, "Slot has more accepted applicants than places")
+		}
+	}
+	if places > maxSlotPlaces {
+		return status.Errorf(codes.InvalidArgument, "At most %d places in total", maxSlotPlaces)
+	}
+	for _, n := range filled {
+		if n > 0 {
+			return status.Error(codes.FailedPrecondition, "Cannot remove a slot with accepted applicants")
+		}
+	}
+	return nil
+}
+
+// slotMatches reports whether an applicant with role and boon fits slot.
+func slotMatches(slot *pb.RoleSlot, role pb.Role, boon pb.Boon) bool {
+	return slot.Role == role && (slot.Boon == pb.Boon_BOON_UNSPECIFIED || slot.Boon == boon)
+}
+
+// checkSlot returns an error if group has slots, but none with room for
+// the role of application.
+func checkSlot(group *pb.Group, application *pb.GroupApplication) error {
+	if len(group.Slots) == 0 {
+		return nil
+	}
+	if application.Role == pb.Role_ROLE_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "Group requires a role")
+	}
+	matched := false
+	for _, slot := range group.Slots {
+		if !slotMatches(slot, application.Role, application.Boon) {
+			continue
+		}
+		if slot.Filled < slot.Count {
+			return nil
+		}
+		matched = true
+	}
+	if matched {
+		return status.Error(codes.FailedPrecondition, "Role is already full")
+	}
+	return status.Error(codes.FailedPrecondition, "Group has no slot for this role")
+}
+
+// filterBySlots drops groups without an open slot for the requested role.
+func filterBySlots(groups []*pb.Group, req *pb.ListGroupsRequest) []*pb.Group {
+	if req.OpenRole == pb.Role_ROLE_UNSPECIFIED {
+		return groups
+	}
+	return slices.DeleteFunc(groups, func(group *pb.Group) bool {
+		return !slices.ContainsFunc(group.Slots, func(slot *pb.RoleSlot) bool {
+			return slot.Role == req.OpenRole &&
+				(req.OpenBoon == pb.Boon_BOON_UNSPECIFIED || slot.Boon == pb.Boon_BOON_UNSPECIFIED || slot.Boon == req.OpenBoon) &&
+				slot.Filled < slot.Count
+		})
+	})
+}
+
+func (s *Server) ReviewGroupApplication(ctx context.Context, req *pb.ReviewGroupApplicationRequest) (*pb.ReviewGroupApplicationResponse, error) {
+	client := mustGetClient(ctx)
+	if _, ok := pb.ApplicationStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown application status")
+	}
+
+	application, err := s.db.GetApplication(ctx, req.ApplicationId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to get application")
+	}
+	if application == nil {
+		return nil, status.Error(codes.NotFound, "Application not found")
+	}
+	// Fetched before locking, kill proof comes from an external API
+	s.attachKillProof(ctx, application)
+
+	unlock := s.slotLocks.lock(application.GroupId)
+	defer unlock()
+	group, err := s.validateGroupOwnership(ctx, application.GroupId, client.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	application.SlotId = ""
+	if req.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED {
+		slot, err := acceptSlot(group, application, req.SlotId)
+		if err != nil {
+			return nil, err
+		}
+		if slot != nil {
+			application.SlotId = slot.Id
+		}
+	}
+	application.Status = req.Status
+
+	savedApp, err := s.db.SaveApplication(ctx, application, application.GroupId)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveApplication", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to review application")
+	}
+	s.refreshSlots(ctx, group.Id)
+	savedApp.KillProof = application.KillProof
+
+	s.broadcastApplicationUpdate(savedApp.GroupId, savedApp.AccountName, &pb.GroupApplicationUpdate{
+		Update: &pb.GroupApplicationUpdate_UpdatedApplication{UpdatedApplication: savedApp},
+	})
+
+	return &pb.ReviewGroupApplicationResponse{Application: savedApp}, nil
+}
+
+// acceptSlot returns the slot of group that application is accepted into,
+// slotID or else the first open one matching the applicant's role. It is
+// nil for groups without slots.
+func acceptSlot(group *pb.Group, application *pb.GroupApplication, slotID string) (*pb.RoleSlot, error) {
+	if len(group.Slots) == 0 {
+		if slotID != "" {
+			return nil, status.Error(codes.InvalidArgument, "Group has no slots")
+		}
+		return nil, nil
+	}
+	// Accepting again keeps the slot
+	current := ""
+	if application.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED {
+		current = application.SlotId
+	}
+	if slotID == "" {
+		slotID = current
+	}
+
+	if slotID == "" {
+		for _, slot := range group.Slots {
+			if slotMatches(slot, application.Role, application.Boon) && slot.Filled < slot.Count {
+				return slot, nil
+			}
+		}
+		return nil, status.Error(codes.FailedPrecondition, "No open slot for this role")
+	}
+	for _, slot := range group.Slots {
+		if slot.Id != slotID {
+			continue
+		}
+		// The commander may put anyone into a slot, as long as there is room
+		if slot.Id != current && slot.Filled >= slot.Count {
+			return nil, status.Error(codes.FailedPrecondition, "Slot is full")
+		}
+		return slot, nil
+	}
+	return nil, status.Error(codes.NotFound, "Slot not found")
+}
+
+// refreshSlots recounts the accepted applications in each slot of a group
+// and announces the group if anything changed. The caller must hold the
+// group's slot lock.
+func (s *Server) refreshSlots(ctx context.Context, groupID string) {
+	group, err := s.db.GetGroup(ctx, groupID)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetGroup", "err", err)
+		return
+	}
+	if group == nil || len(group.Slots) == 0 {
+		return
+	}
+	applications, err := s.db.ListApplicationsForGroup(ctx, groupID)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListApplicationsForGroup", "err", err)
+		return
+	}
+
+	filled := make(map[string]uint32)
+	for _, application := range applications {
+		if application.Status == pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED && application.SlotId != "" {
+			filled[application.SlotId]++
+		}
+	}
+	changed := false
+	slots := make([]*pb.RoleSlot, len(group.Slots))
+	for i, slot := range group.Slots {
+		slots[i] = proto.Clone(slot).(*pb.RoleSlot)
+		slots[i].Filled = filled[slot.Id]
+		changed = changed || slots[i].Filled != slot.Filled
+	}
+	if !changed {
+		return
+	}
+
+	updated, err := s.db.SetGroupSlots(ctx, groupID, slots)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SetGroupSlots", "err", err)
+		return
+	}
+	if updated != nil {
+		s.broadcastGroupChange(group, updated)
+	}
+}
+
+// releaseSlots refreshes the slots of the groups of removed applications
+// that had been accepted.
+func (s *Server) releaseSlots(ctx context.Context, applications ...*pb.GroupApplication) {
+	refreshed := make(map[string]bool)
+	for _, application := range applications {
+		if application.Status != pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED || refreshed[application.GroupId] {
+			continue
+		}
+		refreshed[application.GroupId] = true
+		unlock := s.slotLocks.lock(application.GroupId)
+		s.refreshSlots(ctx, application.GroupId)
+		unlock()
+	}
+}
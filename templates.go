@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"gw2lfgserver/clientinfo"
+	pb "gw2lfgserver/pb"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxTemplatesPerAccount = 10
+	maxTemplateNameLength  = 100
+	// defaultTemplateLead is used for templates without a lead time.
+	defaultTemplateLead = 24 * time.Hour
+	// maxTemplateLead is a week, so the group of one occurrence is never
+	// due before the previous occurrence started.
+	maxTemplateLead = 7 * 24 * time.Hour
+)
+
+func (s *Server) CreateGroupTemplate(ctx context.Context, req *pb.CreateGroupTemplateRequest) (*pb.CreateGroupTemplateResponse, error) {
+	client := mustGetClient(ctx)
+	template := req.GetTemplate()
+	if template == nil {
+		return nil, status.Error(codes.InvalidArgument, "Template is required")
+	}
+	if err := s.validateTemplate(template, client); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.db.ListTemplatesForAccount(ctx, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListTemplatesForAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to validate template creation")
+	}
+	if len(templates) >= maxTemplatesPerAccount {
+		return nil, status.Errorf(codes.ResourceExhausted, "At most %d templates per account", maxTemplatesPerAccount)
+	}
+
+	now := time.Now()
+	template.Id = uuid.New().String()
+	template.OwnerId = client.AccountName
+	template.CreatedAtSec = now.Unix()
+	template.UpdatedAtSec = now.Unix()
+	template.LastStartAtSec = 0
+
+	saved, err := s.db.SaveTemplate(ctx, template)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveTemplate", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to create template")
+	}
+	return &pb.CreateGroupTemplateResponse{Template: saved}, nil
+}
+
+func (s *Server) UpdateGroupTemplate(ctx context.Context, req *pb.UpdateGroupTemplateRequest) (*pb.UpdateGroupTemplateResponse, error) {
+	client := mustGetClient(ctx)
+	template := req.GetTemplate()
+	if template == nil {
+		return nil, status.Error(codes.InvalidArgument, "Template is required")
+	}
+	existing, err := s.validateTemplateOwnership(ctx, template.Id, client.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTemplate(template, client); err != nil {
+		return nil, err
+	}
+
+	template.OwnerId = existing.OwnerId
+	template.CreatedAtSec = existing.CreatedAtSec
+	template.UpdatedAtSec = time.Now().Unix()
+	template.LastStartAtSec = existing.LastStartAtSec
+
+	saved, err := s.db.SaveTemplate(ctx, template)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.SaveTemplate", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to update template")
+	}
+	return &pb.UpdateGroupTemplateResponse{Template: saved}, nil
+}
+
+func (s *Server) DeleteGroupTemplate(ctx context.Context, req *pb.DeleteGroupTemplateRequest) (*pb.DeleteGroupTemplateResponse, error) {
+	client := mustGetClient(ctx)
+	if _, err := s.validateTemplateOwnership(ctx, req.TemplateId, client.AccountName); err != nil {
+		return nil, err
+	}
+	if err := s.db.DeleteTemplate(ctx, req.TemplateId); err != nil {
+		slog.ErrorContext(ctx, "s.db.DeleteTemplate", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to delete template")
+	}
+	return &pb.DeleteGroupTemplateResponse{}, nil
+}
+
+func (s *Server) ListGroupTemplates(ctx context.Context, req *pb.ListGroupTemplatesRequest) (*pb.ListGroupTemplatesResponse, error) {
+	client := mustGetClient(ctx)
+	templates, err := s.db.ListTemplatesForAccount(ctx, client.AccountName)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListTemplatesForAccount", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to list templates")
+	}
+	return &pb.ListGroupTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *Server) validateTemplateOwnership(ctx context.Context, templateID, accountID string) (*pb.GroupTemplate, error) {
+	template, err := s.db.GetTemplate(ctx, templateID)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.GetTemplate", "err", err)
+		return nil, status.Error(codes.Internal, "Failed to validate template ownership")
+	}
+	if template == nil || template.OwnerId != accountID {
+		return nil, status.Error(codes.NotFound, "Template not found")
+	}
+	return template, nil
+}
+
+// validateTemplate checks a template as if client created a group from it
+// now. Guild membership is only checked here, not when the scheduler later
+// creates the group.
+func (s *Server) validateTemplate(template *pb.GroupTemplate, client *clientinfo.ClientInfo) error {
+	name := strings.TrimSpace(template.Name)
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "Name is required")
+	}
+	if utf8.RuneCountInString(name) > maxTemplateNameLength {
+		return status.Errorf(codes.InvalidArgument, "Name must be at most %d characters", maxTemplateNameLength)
+	}
+	template.Name = name
+
+	if template.Group == nil {
+		return status.Error(codes.InvalidArgument, "Group settings are required")
+	}
+	// Each created group gets its own start time
+	template.Group.StartAtSec = 0
+	group, _, err := s.newGroup(client.AccountName, template.Group, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := validateVisibility(group, client); err != nil {
+		return err
+	}
+	template.Group.GuildIds = group.GuildIds
+
+	if template.LeadTimeSec < 0 || time.Duration(template.LeadTimeSec)*time.Second > maxTemplateLead {
+		return status.Errorf(codes.InvalidArgument, "Lead time must be between 0 and %d days", int(maxTemplateLead.Hours()/24))
+	}
+	return validateRecurrence(template.Recurrence)
+}
+
+func validateRecurrence(recurrence *pb.Recurrence) error {
+	if recurrence == nil {
+		return nil
+	}
+	if len(recurrence.Days) == 0 {
+		return status.Error(codes.InvalidArgument, "Recurrence needs at least one day")
+	}
+	for _, day := range recurrence.Days {
+		if _, ok := pb.Weekday_name[int32(day)]; !ok {
+			return status.Error(codes.InvalidArgument, "Unknown weekday")
+		}
+	}
+	if recurrence.StartMinute >= 24*60 {
+		return status.Error(codes.InvalidArgument, "Start minute must be within the day")
+	}
+	// Local would depend on where the server runs
+	if recurrence.TimeZone == "" || recurrence.TimeZone == "Local" {
+		return status.Error(codes.InvalidArgument, "Time zone is required")
+	}
+	if _, err := time.LoadLocation(recurrence.TimeZone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Unknown time zone %s", recurrence.TimeZone)
+	}
+	return nil
+}
+
+// nextOccurrence returns the first start of recurrence after t.
+func nextOccurrence(recurrence *pb.Recurrence, t time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(recurrence.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := t.In(loc)
+	// Eight days, as today's occurrence may already have passed
+	for i := 0; i <= 7; i++ {
+		// time.Date moves times that fall into a DST gap
+		start := time.Date(local.Year(), local.Month(), local.Day()+i, 0, int(recurrence.StartMinute), 0, 0, loc)
+		if start.After(t) && slices.Contains(recurrence.Days, pb.Weekday(start.Weekday())) {
+			return start, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+// materialiseTemplates creates the groups of recurring templates whose
+// next occurrence is within their lead time. A group that can't be created
+// yet, e.g. because the owner still has a group, is retried on later ticks
+// until the occurrence starts.
+func (s *Server) materialiseTemplates(ctx context.Context, now time.Time) {
+	templates, err := s.db.ListRecurringTemplates(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "s.db.ListRecurringTemplates", "err", err)
+		return
+	}
+	for _, template := range templates {
+		after := now
+		if last := time.Unix(template.LastStartAtSec, 0); last.After(after) {
+			after = last
+		}
+		start, err := nextOccurrence(template.Recurrence, after)
+		if err != nil {
+			slog.ErrorContext(ctx, "nextOccurrence", "err", err, "template_id", template.Id)
+			continue
+		}
+		lead := time.Duration(template.LeadTimeSec) * time.Second
+		if lead == 0 {
+			lead = defaultTemplateLead
+		}
+		if start.IsZero() || start.Add(-lead).After(now) {
+			continue
+		}
+		if err := s.materialiseTemplate(ctx, template, start, now); err != nil {
+			slog.InfoContext(ctx, "skipped group template", "template_id", template.Id, "owner", template.OwnerId, "err", err)
+		}
+	}
+}
+
+func (s *Server) materialiseTemplate(ctx context.Context, template *pb.GroupTemplate, start, now time.Time) error {
+	ban, err := s.db.GetActiveBan(ctx, template.OwnerId, now)
+	if err != nil {
+		return err
+	}
+	if ban != nil {
+		return status.Error(codes.PermissionDenied, "Account is banned")
+	}
+	if err := s.validateNewGroup(ctx, template.OwnerId); err != nil {
+		return err
+	}
+
+	req := proto.Clone(template.Group).(*pb.CreateGroupRequest)
+	req.StartAtSec = start.Unix()
+	group, flagged, err := s.newGroup(template.OwnerId, req, now)
+	if err != nil {
+		return err
+	}
+	if _, err := s.saveNewGroup(ctx, group, flagged); err != nil {
+		return err
+	}
+	if err := s.db.SetTemplateLastStart(ctx, template.Id, start); err != nil {
+		// The next tick would create the same occurrence again, but the
+		// owner's group stops that
+		slog.ErrorContext(ctx, "s.db.SetTemplateLastStart", "err", err)
+	}
+	slog.InfoContext(ctx, "created group from template", "template_id", template.Id, "group_id", group.Id)
+	return nil
+}
@@ -0,0 +1,33 @@
+// Package tokenhash derives stable identifiers from API keys and session
+// tokens, so raw credentials never have to be kept around as map keys.
+package tokenhash
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+type Hasher struct {
+	salt []byte
+}
+
+// New creates a hasher using the given salt. An empty salt is replaced by a
+// random one, which is fine as long as hashes are not persisted.
+func New(salt []byte) *Hasher {
+	if len(salt) == 0 {
+		salt = make([]byte, 32)
+		if _, err := rand.Read(salt); err != nil {
+			panic(err)
+		}
+	}
+	return &Hasher{salt: salt}
+}
+
+// Hash returns the hex encoded salted hash of token.
+func (h *Hasher) Hash(token string) string {
+	mac := hmac.New(sha256.New, h.salt)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
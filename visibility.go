@@ -0,0 +1,82 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"gw2lfgserver/clientinfo"
+	pb "gw2lfgserver/pb"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateVisibility checks the visibility settings of a group created or
+// updated by client.
+func validateVisibility(group *pb.Group, client *clientinfo.ClientInfo) error {
+	switch group.Visibility {
+	case pb.GroupVisibility_VISIBILITY_PUBLIC, pb.GroupVisibility_VISIBILITY_UNLISTED:
+		group.GuildIds = nil
+	case pb.GroupVisibility_VISIBILITY_GUILD:
+		if len(group.GuildIds) == 0 {
+			return status.Error(codes.InvalidArgument, "Guild visibility requires at least one guild")
+		}
+		for _, guildID := range group.GuildIds {
+			if client.Profile == nil || !slices.Contains(client.Profile.Guilds, guildID) {
+				return status.Errorf(codes.InvalidArgument, "Not a member of guild %s", guildID)
+			}
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown group visibility")
+	}
+	return nil
+}
+
+// canView reports whether client may see group. Unlisted groups can only
+// be seen by their creator, or with the group's invite code. Hidden groups
+// are only seen by their creator. A nil client only sees public groups.
+func canView(group *pb.Group, client *clientinfo.ClientInfo, inviteCode string) bool {
+	if client != nil && client.AccountName == group.CreatorId {
+		return true
+	}
+	if group.Hidden {
+		return false
+	}
+	switch group.Visibility {
+	case pb.GroupVisibility_VISIBILITY_PUBLIC:
+		return true
+	case pb.GroupVisibility_VISIBILITY_GUILD:
+		if client == nil || client.Profile == nil {
+			return false
+		}
+		for _, guildID := range group.GuildIds {
+			if slices.Contains(client.Profile.Guilds, guildID) {
+				return true
+			}
+		}
+		return false
+	case pb.GroupVisibility_VISIBILITY_UNLISTED:
+		return inviteCode != "" && inviteCode == group.InviteCode
+	default:
+		return false
+	}
+}
+
+// groupForClient strips the invite code unless client created the group.
+func groupForClient(group *pb.Group, client *clientinfo.ClientInfo) *pb.Group {
+	if group.InviteCode == "" || (client != nil && client.AccountName == group.CreatorId) {
+		return group
+	}
+	stripped := proto.Clone(group).(*pb.Group)
+	stripped.InviteCode = ""
+	return stripped
+}
+
+func newInviteCode() string {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+}